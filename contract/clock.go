@@ -0,0 +1,55 @@
+package contract
+
+import (
+	"context"
+	"time"
+)
+
+// Clock tells the current time to rules that compare against relative dates such as "today"
+// or "+7 days". Inject a fixed clock to make such rules deterministic.
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc adapts a function to the Clock interface
+type ClockFunc func() time.Time
+
+// Now returns the time reported by the function
+func (f ClockFunc) Now() time.Time { return f() }
+
+// SystemClock reads the system time
+var SystemClock Clock = ClockFunc(time.Now)
+
+// FixedClock returns a clock that always reports t
+func FixedClock(t time.Time) Clock {
+	return ClockFunc(func() time.Time { return t })
+}
+
+// clockKey and locationKey identify the clock and timezone in a request context
+type (
+	clockKey    struct{}
+	locationKey struct{}
+)
+
+// WithClock returns a context whose rules read the current time from clock
+func WithClock(ctx context.Context, clock Clock) context.Context {
+	return context.WithValue(ctx, clockKey{}, clock)
+}
+
+// ClockFrom returns the clock carried by ctx, if any
+func ClockFrom(ctx context.Context) (Clock, bool) {
+	clock, ok := ctx.Value(clockKey{}).(Clock)
+	return clock, ok && clock != nil
+}
+
+// WithLocation returns a context whose rules interpret dates without a zone, and relative dates
+// such as "today", in loc
+func WithLocation(ctx context.Context, loc *time.Location) context.Context {
+	return context.WithValue(ctx, locationKey{}, loc)
+}
+
+// LocationFrom returns the timezone carried by ctx, if any
+func LocationFrom(ctx context.Context) (*time.Location, bool) {
+	loc, ok := ctx.Value(locationKey{}).(*time.Location)
+	return loc, ok && loc != nil
+}
@@ -0,0 +1,29 @@
+package contract
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestClockContext(t *testing.T) {
+	ctx := context.Background()
+	if _, ok := ClockFrom(ctx); ok {
+		t.Fatal("did not expect a clock in an empty context")
+	}
+	if _, ok := LocationFrom(ctx); ok {
+		t.Fatal("did not expect a location in an empty context")
+	}
+
+	fixed := time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC)
+	tokyo := time.FixedZone("JST", 9*60*60)
+	ctx = WithLocation(WithClock(ctx, FixedClock(fixed)), tokyo)
+
+	clock, ok := ClockFrom(ctx)
+	if !ok || !clock.Now().Equal(fixed) {
+		t.Fatalf("expected the fixed clock, got %v", clock)
+	}
+	if loc, ok := LocationFrom(ctx); !ok || loc != tokyo {
+		t.Fatalf("expected the Tokyo location, got %v", loc)
+	}
+}
@@ -1,5 +1,7 @@
 package contract
 
+import "context"
+
 // ValidationContext is a concrete implementation of RuleContext
 // Provides context for a single validation rule execution.
 // ValidationContext provides context for validator operations
@@ -8,6 +10,7 @@ type ValidationContext struct {
 	value      any
 	parameters []string
 	data       map[string]any
+	requestCtx context.Context
 	Attributes map[string]string // Custom attribute names
 }
 
@@ -27,6 +30,19 @@ func (ctx *ValidationContext) Value() any           { return ctx.value }
 func (ctx *ValidationContext) Parameters() []string { return ctx.parameters }
 func (ctx *ValidationContext) Data() map[string]any { return ctx.data }
 
+// Context returns the request context of the validation, or context.Background if none was set
+func (ctx *ValidationContext) Context() context.Context {
+	if ctx.requestCtx == nil {
+		return context.Background()
+	}
+	return ctx.requestCtx
+}
+
+// SetContext sets the request context used for cancellation and request-scoped values
+func (ctx *ValidationContext) SetContext(requestCtx context.Context) {
+	ctx.requestCtx = requestCtx
+}
+
 func (ctx *ValidationContext) Attribute(field string) string {
 	if attr, exists := ctx.Attributes[field]; exists {
 		return attr
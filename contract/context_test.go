@@ -1,6 +1,9 @@
 package contract
 
-import "testing"
+import (
+	"context"
+	"testing"
+)
 
 func TestValidationContext(t *testing.T) {
 	ctx := NewValidationContext("email", "a@b.com", []string{"p1"}, map[string]any{"x": 1})
@@ -18,3 +21,17 @@ func TestValidationContext(t *testing.T) {
 		t.Fatal("custom attribute not applied")
 	}
 }
+
+type ctxKey struct{}
+
+func TestValidationContext_RequestContext(t *testing.T) {
+	ctx := NewValidationContext("email", "a@b.com", nil, nil)
+	if ctx.Context() != context.Background() {
+		t.Fatal("expected background context by default")
+	}
+	reqCtx := context.WithValue(context.Background(), ctxKey{}, "tenant-1")
+	ctx.SetContext(reqCtx)
+	if ctx.Context().Value(ctxKey{}) != "tenant-1" {
+		t.Fatal("request context not applied")
+	}
+}
@@ -0,0 +1,149 @@
+package contract
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ParamType describes what a rule parameter holds
+type ParamType string
+
+// Parameter types used in rule descriptors
+const (
+	ParamString  ParamType = "string"
+	ParamNumber  ParamType = "number"
+	ParamInteger ParamType = "integer"
+	ParamField   ParamType = "field"
+	// ParamDate holds a date, a relative date such as "today" or "+7 days", or a field path
+	ParamDate    ParamType = "date"
+	ParamPattern ParamType = "pattern"
+	// ParamOperand holds a number or the path of another field to compare with
+	ParamOperand ParamType = "operand"
+)
+
+// Value types accepted by rules, used in RuleDescriptor.ValueTypes
+const (
+	ValueAny     = "any"
+	ValueString  = "string"
+	ValueNumeric = "numeric"
+	ValueArray   = "array"
+	ValueFile    = "file"
+	ValueDate    = "date"
+	ValueBoolean = "boolean"
+)
+
+// ParamSpec describes a single rule parameter
+type ParamSpec struct {
+	Name string    `json:"name"`
+	Type ParamType `json:"type"`
+	// Optional parameters may be omitted; only trailing parameters can be optional
+	Optional bool `json:"optional,omitempty"`
+	// Variadic marks the last parameter as repeating for all remaining values
+	Variadic bool `json:"variadic,omitempty"`
+	// Placeholder is the message placeholder bound to the parameter, e.g. "values" for :values.
+	// It defaults to Name.
+	Placeholder string `json:"placeholder,omitempty"`
+}
+
+// PlaceholderName returns the name of the message placeholder bound to the parameter
+func (p ParamSpec) PlaceholderName() string {
+	if p.Placeholder != "" {
+		return p.Placeholder
+	}
+	return p.Name
+}
+
+// RuleDescriber looks up rule descriptors by rule name; Registry implements it
+type RuleDescriber interface {
+	Describe(name string) (RuleDescriptor, bool)
+}
+
+// RuleDescriptor describes a rule for documentation, editor tooling and rule string checks
+type RuleDescriptor struct {
+	Name        string      `json:"name"`
+	Category    string      `json:"category,omitempty"`
+	Description string      `json:"description,omitempty"`
+	Params      []ParamSpec `json:"params,omitempty"`
+	// ValueTypes lists the value types the rule validates, e.g. "string" or "numeric"
+	ValueTypes     []string `json:"value_types,omitempty"`
+	DefaultMessage string   `json:"default_message,omitempty"`
+	// Implicit rules run even when the field is missing or empty
+	Implicit bool `json:"implicit,omitempty"`
+	// Dependent rules read other fields of the input
+	Dependent bool `json:"dependent,omitempty"`
+	// Aliases lists the other names the rule can be referred to by; the registry fills it in
+	Aliases []string `json:"aliases,omitempty"`
+}
+
+// Arity returns the minimum and maximum number of parameters; max is -1 when unbounded
+func (d RuleDescriptor) Arity() (minParams, maxParams int) {
+	for _, p := range d.Params {
+		if p.Variadic {
+			if !p.Optional {
+				minParams++
+			}
+			return minParams, -1
+		}
+		if !p.Optional {
+			minParams++
+		}
+		maxParams++
+	}
+	return minParams, maxParams
+}
+
+// CheckParams validates the number of parameters and the format of numeric parameters.
+// The returned error wraps ErrInvalidRule.
+func (d RuleDescriptor) CheckParams(params []string) error {
+	minParams, maxParams := d.Arity()
+	if len(params) < minParams {
+		return fmt.Errorf("%w: %s requires at least %d parameter(s), got %d",
+			ErrInvalidRule, d.Name, minParams, len(params))
+	}
+	if maxParams >= 0 && len(params) > maxParams {
+		return fmt.Errorf("%w: %s accepts at most %d parameter(s), got %d",
+			ErrInvalidRule, d.Name, maxParams, len(params))
+	}
+
+	for i, param := range params {
+		spec := d.paramAt(i)
+		switch spec.Type {
+		case ParamNumber:
+			if _, err := strconv.ParseFloat(param, 64); err != nil {
+				return fmt.Errorf("%w: %s parameter %s must be a number, got %q",
+					ErrInvalidRule, d.Name, spec.Name, param)
+			}
+		case ParamInteger:
+			if _, err := strconv.Atoi(param); err != nil {
+				return fmt.Errorf("%w: %s parameter %s must be an integer, got %q",
+					ErrInvalidRule, d.Name, spec.Name, param)
+			}
+		}
+	}
+	return nil
+}
+
+// FieldParams returns the parameters that name other fields of the input
+func (d RuleDescriptor) FieldParams(params []string) []string {
+	var fields []string
+	for i, param := range params {
+		if d.paramAt(i).Type == ParamField {
+			fields = append(fields, param)
+		}
+	}
+	return fields
+}
+
+// paramAt returns the spec for the parameter at index i, repeating a variadic last parameter
+func (d RuleDescriptor) paramAt(i int) ParamSpec {
+	if len(d.Params) == 0 {
+		return ParamSpec{}
+	}
+	if i < len(d.Params) {
+		return d.Params[i]
+	}
+	if last := d.Params[len(d.Params)-1]; last.Variadic {
+		return last
+	}
+	return ParamSpec{}
+}
@@ -0,0 +1,63 @@
+package contract
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRuleDescriptor_ArityAndCheckParams(t *testing.T) {
+	between := RuleDescriptor{Name: "between", Params: []ParamSpec{
+		{Name: "min", Type: ParamNumber},
+		{Name: "max", Type: ParamNumber},
+	}}
+	decimal := RuleDescriptor{Name: "decimal", Params: []ParamSpec{
+		{Name: "min", Type: ParamInteger},
+		{Name: "max", Type: ParamInteger, Optional: true},
+	}}
+	requiredIf := RuleDescriptor{Name: "required_if", Params: []ParamSpec{
+		{Name: "other", Type: ParamField},
+		{Name: "values", Type: ParamString, Variadic: true},
+	}}
+
+	tests := []struct {
+		descriptor RuleDescriptor
+		params     []string
+		wantErr    bool
+	}{
+		{between, []string{"1", "2.5"}, false},
+		{between, []string{"1"}, true},
+		{between, []string{"1", "x"}, true},
+		{between, []string{"1", "2", "3"}, true},
+		{decimal, []string{"2"}, false},
+		{decimal, []string{"2", "4"}, false},
+		{decimal, []string{"2.5"}, true},
+		{requiredIf, []string{"status", "a", "b", "c"}, false},
+		{requiredIf, []string{"status"}, true},
+		{RuleDescriptor{Name: "email"}, []string{"rfc"}, true},
+	}
+	for _, tt := range tests {
+		err := tt.descriptor.CheckParams(tt.params)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s%v: unexpected error %v", tt.descriptor.Name, tt.params, err)
+		}
+		if err != nil && !errors.Is(err, ErrInvalidRule) {
+			t.Errorf("expected ErrInvalidRule, got %v", err)
+		}
+	}
+
+	if minParams, maxParams := decimal.Arity(); minParams != 1 || maxParams != 2 {
+		t.Fatalf("unexpected decimal arity: %d..%d", minParams, maxParams)
+	}
+	if fields := requiredIf.FieldParams([]string{"status", "active"}); len(fields) != 1 || fields[0] != "status" {
+		t.Fatalf("unexpected field params: %v", fields)
+	}
+}
+
+func TestParamSpec_PlaceholderName(t *testing.T) {
+	if got := (ParamSpec{Name: "other"}).PlaceholderName(); got != "other" {
+		t.Errorf("expected name as default placeholder, got %q", got)
+	}
+	if got := (ParamSpec{Name: "prefixes", Placeholder: "values"}).PlaceholderName(); got != "values" {
+		t.Errorf("expected explicit placeholder, got %q", got)
+	}
+}
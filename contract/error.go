@@ -13,6 +13,9 @@ var (
 	ErrRuleNotFound = errors.New("rule not found")
 	ErrInvalidRule  = errors.New("invalid rule")
 	ErrInvalidData  = errors.New("invalid data")
+	// ErrValidationCanceled is returned when validation stops early because its context is done.
+	// It wraps the context error, so errors.Is also matches context.Canceled or context.DeadlineExceeded.
+	ErrValidationCanceled = errors.New("validation canceled")
 )
 
 // IsValidationFailed checks if an error is a validator failure
@@ -20,6 +23,11 @@ func IsValidationFailed(err error) bool {
 	return err != nil && err.Error() == validatorErrors.ErrValidationFailed.Error()
 }
 
+// NewCanceledError wraps a context error with ErrValidationCanceled
+func NewCanceledError(cause error) error {
+	return fmt.Errorf("%w: %w", ErrValidationCanceled, cause)
+}
+
 // NewValidationError creates a new validator error with formatting
 func NewValidationError(format string, args ...interface{}) error {
 	return fmt.Errorf(format, args...)
@@ -0,0 +1,108 @@
+package contract
+
+import (
+	"encoding/json"
+	"mime/multipart"
+	"reflect"
+)
+
+// FailureCodePrefix is the namespace shared by every failure code
+const FailureCodePrefix = "validation"
+
+// Failure codes for errors raised by the engine rather than by a rule
+const (
+	CodeUnknownRule = FailureCodePrefix + ".unknown_rule"
+	CodeInvalidRule = FailureCodePrefix + ".invalid_rule"
+)
+
+// ValueKind classifies a value the way size rules measure it
+type ValueKind string
+
+// Value kinds used for size rule codes and message variants
+const (
+	KindNumeric ValueKind = "numeric"
+	KindString  ValueKind = "string"
+	KindArray   ValueKind = "array"
+	KindFile    ValueKind = "file"
+)
+
+// sizeRules lists the rules whose meaning depends on the kind of the value
+var sizeRules = map[string]bool{
+	"min": true, "max": true, "size": true, "between": true,
+	"gt": true, "gte": true, "lt": true, "lte": true,
+}
+
+// IsSizeRule reports whether a rule measures numbers, string lengths, element counts or file sizes
+func IsSizeRule(name string) bool {
+	return sizeRules[name]
+}
+
+// KindOf returns the kind of value, or an empty kind for values size rules cannot measure
+func KindOf(value any) ValueKind {
+	switch value.(type) {
+	case nil:
+		return ""
+	case string:
+		return KindString
+	case json.Number:
+		return KindNumeric
+	case *multipart.FileHeader, multipart.FileHeader:
+		return KindFile
+	}
+
+	val := reflect.ValueOf(value)
+	for val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return ""
+		}
+		val = val.Elem()
+	}
+
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return KindNumeric
+	case reflect.String:
+		return KindString
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return KindArray
+	}
+	return ""
+}
+
+// FailureCode returns the stable code of a rule failure, e.g. "validation.required".
+// Size rules include the kind of the value, e.g. "validation.min.string".
+func FailureCode(rule string, value any) string {
+	code := FailureCodePrefix + "." + rule
+	if IsSizeRule(rule) {
+		if kind := KindOf(value); kind != "" {
+			code += "." + string(kind)
+		}
+	}
+	return code
+}
+
+// FieldFailure is the structured record of a single failed rule
+type FieldFailure struct {
+	// Field is the concrete field path, e.g. "items.2.price"
+	Field string `json:"field"`
+	// Rule is the name of the failed rule
+	Rule string `json:"rule,omitempty"`
+	// Attribute is the attribute name the message refers to the field by, when the resolver reports it
+	Attribute string `json:"attribute,omitempty"`
+	// Params holds the rule parameters, with wildcards resolved
+	Params []string `json:"params,omitempty"`
+	// Value is the offending value; it is nil once redacted
+	Value any `json:"value,omitempty"`
+	// Redacted reports whether Value was removed
+	Redacted bool `json:"redacted,omitempty"`
+	// Reason is the key of the typed failure reason reported by the rule, e.g. "password.symbols"
+	Reason string `json:"reason,omitempty"`
+	// Code is a stable, machine-readable code such as "validation.min.string"
+	Code string `json:"code,omitempty"`
+	// Message is the rendered message
+	Message string `json:"message"`
+	// Template is the message with the :attribute placeholder left in place, when the resolver reports it
+	Template string `json:"-"`
+}
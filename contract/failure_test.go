@@ -0,0 +1,52 @@
+package contract
+
+import (
+	"encoding/json"
+	"mime/multipart"
+	"testing"
+)
+
+func TestKindOf(t *testing.T) {
+	n := 3
+	tests := []struct {
+		value any
+		want  ValueKind
+	}{
+		{nil, ""},
+		{"abc", KindString},
+		{42, KindNumeric},
+		{&n, KindNumeric},
+		{uint8(1), KindNumeric},
+		{2.5, KindNumeric},
+		{json.Number("1.5"), KindNumeric},
+		{[]any{1}, KindArray},
+		{map[string]any{}, KindArray},
+		{&multipart.FileHeader{}, KindFile},
+		{true, ""},
+	}
+	for _, tt := range tests {
+		if got := KindOf(tt.value); got != tt.want {
+			t.Errorf("KindOf(%#v) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestFailureCode(t *testing.T) {
+	tests := []struct {
+		rule  string
+		value any
+		want  string
+	}{
+		{"required", "", "validation.required"},
+		{"min", "ab", "validation.min.string"},
+		{"max", 10, "validation.max.numeric"},
+		{"size", []any{1}, "validation.size.array"},
+		{"between", nil, "validation.between"},
+		{"email", 10, "validation.email"},
+	}
+	for _, tt := range tests {
+		if got := FailureCode(tt.rule, tt.value); got != tt.want {
+			t.Errorf("FailureCode(%q, %#v) = %q, want %q", tt.rule, tt.value, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package contract
+
+import "sort"
+
+// FieldRules pairs a field path with its rule string.
+// A slice of FieldRules is an ordered rules input: fields are validated, and their errors
+// reported, in declaration order.
+type FieldRules struct {
+	Field string
+	Rules string
+}
+
+// SortedFieldRules converts a rules map into FieldRules sorted by field name,
+// giving map inputs a deterministic validation and error order.
+func SortedFieldRules(rules map[string]string) []FieldRules {
+	fields := make([]string, 0, len(rules))
+	for field := range rules {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	ordered := make([]FieldRules, 0, len(fields))
+	for _, field := range fields {
+		ordered = append(ordered, FieldRules{Field: field, Rules: rules[field]})
+	}
+	return ordered
+}
+
+// CompiledRules is a rules input that a ValidationEngine has parsed and instantiated once.
+// Implementations hold no per-call state and are safe for concurrent use.
+type CompiledRules interface {
+	// Fields returns the compiled field patterns in declaration order.
+	Fields() []string
+}
@@ -1,9 +1,12 @@
 package contract
 
+import "golang.org/x/text/language"
+
 // MessageResolver resolves validator error messages
 type MessageResolver interface {
-	// Resolve creates a validator error message
-	Resolve(rule string, field string, parameters []string) string
+	// Resolve creates a validator error message. value is the validated value, which lets
+	// resolvers pick type-specific messages for size rules.
+	Resolve(rule string, field string, value any, parameters []string) string
 
 	// SetCustomMessage sets a custom message for a rule
 	SetCustomMessage(rule string, message string)
@@ -14,3 +17,51 @@ type MessageResolver interface {
 	// Clone creates a copy of the message resolver for request isolation
 	Clone() MessageResolver
 }
+
+// LocaleResolver is a MessageResolver that selects messages and attribute names by locale
+type LocaleResolver interface {
+	MessageResolver
+
+	// SetLocale selects the locale used for messages and attribute names
+	SetLocale(tag language.Tag)
+
+	// Locale returns the selected locale
+	Locale() language.Tag
+}
+
+// DescriptorResolver is a MessageResolver that renders named placeholders such as :other or :min
+// by looking up the parameter names declared in rule descriptors
+type DescriptorResolver interface {
+	MessageResolver
+
+	// SetRuleDescriber sets where rule descriptors are looked up
+	SetRuleDescriber(describer RuleDescriber)
+}
+
+// ReasonResolver is a MessageResolver that localizes typed failure reasons reported by rules
+type ReasonResolver interface {
+	MessageResolver
+
+	// ResolveReason creates the message of a reason reported by rule. Resolvers fall back to the
+	// reason's own message when no message is defined for its key.
+	ResolveReason(rule string, field string, value any, parameters []string, reason Reason) string
+}
+
+// AttributeResolver is a MessageResolver that reports the attribute name used for a field, so
+// that failures can record how their message refers to the field
+type AttributeResolver interface {
+	MessageResolver
+
+	// Attribute returns the custom or localized attribute name of field, or the field itself
+	Attribute(field string) string
+}
+
+// TemplateResolver is a MessageResolver that renders messages without the attribute name, so
+// that failures can be worded again with another subject, e.g. "This field"
+type TemplateResolver interface {
+	MessageResolver
+
+	// Template renders the message of a failure, of the given reason when it is not nil, with
+	// every placeholder but :attribute replaced
+	Template(rule string, field string, value any, parameters []string, reason *Reason) string
+}
@@ -1,8 +1,37 @@
 package contract
 
+import "context"
+
 // PasswordVerifier is an interface that wraps the Verify method.
 // The Verify method checks if the provided password is correct for the current user.
 // The implementation should handle identifying the current user from the context.
 type PasswordVerifier interface {
 	Verify(password string) (bool, error)
 }
+
+// ContextPasswordVerifier is the context-aware variant of PasswordVerifier.
+// Implementations can identify the current user from request-scoped context values.
+type ContextPasswordVerifier interface {
+	VerifyContext(ctx context.Context, password string) (bool, error)
+}
+
+// passwordVerifierAdapter exposes a PasswordVerifier as a ContextPasswordVerifier
+type passwordVerifierAdapter struct {
+	verifier PasswordVerifier
+}
+
+// AdaptPasswordVerifier wraps a PasswordVerifier so it can be used where a ContextPasswordVerifier
+// is expected. The adapter returns the context error when the context is already done.
+func AdaptPasswordVerifier(verifier PasswordVerifier) ContextPasswordVerifier {
+	if contextVerifier, ok := verifier.(ContextPasswordVerifier); ok {
+		return contextVerifier
+	}
+	return passwordVerifierAdapter{verifier: verifier}
+}
+
+func (a passwordVerifierAdapter) VerifyContext(ctx context.Context, password string) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return a.verifier.Verify(password)
+}
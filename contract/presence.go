@@ -1,8 +1,92 @@
 package contract
 
+import "context"
+
 // PresenceVerifier is the interface for DB existence checks
 // Should be implemented in user code and registered by convention.
 type PresenceVerifier interface {
 	Exists(table string, field string, value any) (bool, error)
 	Unique(table string, field string, value any) (bool, error)
 }
+
+// ContextPresenceVerifier is the context-aware variant of PresenceVerifier.
+// The context carries cancellation and request-scoped values such as the tenant or user.
+type ContextPresenceVerifier interface {
+	ExistsContext(ctx context.Context, table string, field string, value any) (bool, error)
+	UniqueContext(ctx context.Context, table string, field string, value any) (bool, error)
+}
+
+// presenceVerifierAdapter exposes a PresenceVerifier as a ContextPresenceVerifier
+type presenceVerifierAdapter struct {
+	verifier PresenceVerifier
+}
+
+// AdaptPresenceVerifier wraps a PresenceVerifier so it can be used where a ContextPresenceVerifier
+// is expected. The adapter returns the context error when the context is already done.
+func AdaptPresenceVerifier(verifier PresenceVerifier) ContextPresenceVerifier {
+	if contextVerifier, ok := verifier.(ContextPresenceVerifier); ok {
+		return contextVerifier
+	}
+	return presenceVerifierAdapter{verifier: verifier}
+}
+
+func (a presenceVerifierAdapter) ExistsContext(ctx context.Context, table, field string, value any) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return a.verifier.Exists(table, field, value)
+}
+
+func (a presenceVerifierAdapter) UniqueContext(ctx context.Context, table, field string, value any) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return a.verifier.Unique(table, field, value)
+}
+
+// UniqueExceptVerifier is an optional interface for presence verifiers that check uniqueness
+// while ignoring the row whose idColumn holds except, as in unique:users,email,5,user_id.
+// Verifiers registered through either registry function may implement it.
+type UniqueExceptVerifier interface {
+	UniqueExceptContext(ctx context.Context, table, field string, value, except any, idColumn string) (bool, error)
+}
+
+// BatchPresenceVerifier is an optional interface for presence verifiers that can check many values
+// with a single query. The returned map is keyed by the given values; values that are missing from
+// the map are treated as not existing.
+type BatchPresenceVerifier interface {
+	ExistsMany(table string, field string, values []any) (map[any]bool, error)
+}
+
+// ContextBatchPresenceVerifier is the context-aware variant of BatchPresenceVerifier
+type ContextBatchPresenceVerifier interface {
+	ExistsManyContext(ctx context.Context, table string, field string, values []any) (map[any]bool, error)
+}
+
+// batchPresenceVerifierAdapter exposes a BatchPresenceVerifier as a ContextBatchPresenceVerifier
+type batchPresenceVerifierAdapter struct {
+	verifier BatchPresenceVerifier
+}
+
+// AdaptBatchPresenceVerifier returns the batch capability of verifier, if it has one.
+// Verifiers implementing only BatchPresenceVerifier are adapted like AdaptPresenceVerifier does.
+func AdaptBatchPresenceVerifier(verifier any) (ContextBatchPresenceVerifier, bool) {
+	switch v := verifier.(type) {
+	case ContextBatchPresenceVerifier:
+		return v, true
+	case BatchPresenceVerifier:
+		return batchPresenceVerifierAdapter{verifier: v}, true
+	}
+	return nil, false
+}
+
+func (a batchPresenceVerifierAdapter) ExistsManyContext(
+	ctx context.Context,
+	table, field string,
+	values []any,
+) (map[any]bool, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.verifier.ExistsMany(table, field, values)
+}
@@ -0,0 +1,70 @@
+package contract
+
+import (
+	"errors"
+	"strings"
+)
+
+// Reason is a typed cause of a rule failure, such as a password lacking a symbol. Key is its
+// message key, e.g. "password.symbols", Params hold the values of the named placeholders used
+// by its message and Message is the rule's own text, used when no message is defined for Key.
+// Fields map placeholders to the paths of other fields, which render as their attribute names.
+type Reason struct {
+	Key     string
+	Params  map[string]string
+	Fields  map[string]string
+	Message string
+}
+
+// NewReason creates a failure reason
+func NewReason(key, message string, params map[string]string) Reason {
+	return Reason{Key: key, Params: params, Message: message}
+}
+
+// WithField returns a copy of r whose placeholder name renders the attribute name of the field at path
+func (r Reason) WithField(name, path string) Reason {
+	fields := make(map[string]string, len(r.Fields)+1)
+	for k, v := range r.Fields {
+		fields[k] = v
+	}
+	fields[name] = path
+	r.Fields = fields
+	return r
+}
+
+// ReasonError is returned by rules that report typed failure reasons. The engine records one
+// failure per reason and resolves each reason's message separately.
+type ReasonError struct {
+	Reasons []Reason
+}
+
+// Error joins the messages of all reasons
+func (e *ReasonError) Error() string {
+	messages := make([]string, len(e.Reasons))
+	for i, reason := range e.Reasons {
+		messages[i] = reason.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+// FailWith returns an error reporting the given reasons, or nil when there are none
+func FailWith(reasons ...Reason) error {
+	if len(reasons) == 0 {
+		return nil
+	}
+	return &ReasonError{Reasons: reasons}
+}
+
+// ReasonsOf returns the failure reasons carried by err, if any
+func ReasonsOf(err error) []Reason {
+	var reasonErr *ReasonError
+	if errors.As(err, &reasonErr) {
+		return reasonErr.Reasons
+	}
+	return nil
+}
+
+// ReasonCode returns the failure code of a reason, e.g. "validation.password.symbols"
+func ReasonCode(reason Reason) string {
+	return FailureCodePrefix + "." + reason.Key
+}
@@ -0,0 +1,32 @@
+package contract
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestFailWith(t *testing.T) {
+	if err := FailWith(); err != nil {
+		t.Fatalf("expected nil error without reasons, got %v", err)
+	}
+
+	err := FailWith(
+		NewReason("password.numbers", "must contain a number", nil),
+		NewReason("password.symbols", "must contain a symbol", nil),
+	)
+	if err.Error() != "must contain a number; must contain a symbol" {
+		t.Errorf("unexpected error text: %q", err.Error())
+	}
+
+	reasons := ReasonsOf(fmt.Errorf("wrapped: %w", err))
+	if len(reasons) != 2 || reasons[1].Key != "password.symbols" {
+		t.Fatalf("unexpected reasons: %v", reasons)
+	}
+	if code := ReasonCode(reasons[0]); code != "validation.password.numbers" {
+		t.Errorf("unexpected code: %q", code)
+	}
+	if ReasonsOf(errors.New("plain")) != nil {
+		t.Error("plain errors carry no reasons")
+	}
+}
@@ -3,6 +3,23 @@ package contract
 // Registry holds all available rule creators
 type Registry interface {
 	Register(name string, creator RuleCreator) error
+	// RegisterImplicit registers a rule that runs even when the field is missing or empty
+	RegisterImplicit(name string, creator RuleCreator) error
+	// IsImplicit reports whether the named rule was registered as implicit
+	IsImplicit(name string) bool
+	// RegisterDescriptor registers a rule creator together with its descriptor;
+	// descriptor.Implicit decides whether the rule is implicit
+	RegisterDescriptor(descriptor RuleDescriptor, creator RuleCreator) error
+	// Describe returns the descriptor of a registered rule. Rules registered without one
+	// get a descriptor holding their name, implicit flag and optional variadic parameters.
+	Describe(name string) (RuleDescriptor, bool)
+	// Descriptors returns the descriptors of all registered rules sorted by name
+	Descriptors() []RuleDescriptor
+	// RegisterAlias makes alias resolve to the registered rule name in Get, Has, Describe
+	// and IsImplicit
+	RegisterAlias(alias, name string) error
+	// Canonical returns the rule name an alias resolves to, or name itself
+	Canonical(name string) string
 	Get(name string) (RuleCreator, bool)
 	Has(name string) bool
 	List() []string
@@ -13,6 +30,8 @@ type Registry interface {
 // Config is registry config and holds configuration for the registry
 type Config struct {
 	CustomRules    map[string]RuleCreator
+	ImplicitRules  map[string]bool
+	Descriptors    map[string]RuleDescriptor
 	CustomMessages map[string]string
 	ExcludeRules   map[string]bool
 	IncludeOnly    map[string]bool
@@ -10,6 +10,9 @@ type Result interface {
 	// Errors returns all validator errors grouped by field
 	Errors() map[string][]string
 
+	// Fields returns the fields with errors in the order they were validated
+	Fields() []string
+
 	// FirstError returns the first validator error, if any
 	FirstError() string
 
@@ -18,11 +21,16 @@ type Result interface {
 
 	// HasFieldError reports whether a field has validator errors
 	HasFieldError(field string) bool
+
+	// Failures returns every failure as a structured entry, in the order they were recorded
+	Failures() []FieldFailure
 }
 
 // ValidationErrors is a concrete implementation of Result
 type ValidationErrors struct {
-	errors map[string][]string
+	errors   map[string][]string
+	fields   []string
+	failures []FieldFailure
 }
 
 // NewValidationErrors creates a new ValidationErrors instance
@@ -32,9 +40,18 @@ func NewValidationErrors() *ValidationErrors {
 	}
 }
 
-// AddError adds an error for a specific field
+// AddError adds an error message for a specific field without rule details
 func (ve *ValidationErrors) AddError(field, message string) {
-	ve.errors[field] = append(ve.errors[field], message)
+	ve.AddFailure(FieldFailure{Field: field, Message: message})
+}
+
+// AddFailure records a structured failure; its message is also added to the Errors view
+func (ve *ValidationErrors) AddFailure(failure FieldFailure) {
+	if _, exists := ve.errors[failure.Field]; !exists {
+		ve.fields = append(ve.fields, failure.Field)
+	}
+	ve.errors[failure.Field] = append(ve.errors[failure.Field], failure.Message)
+	ve.failures = append(ve.failures, failure)
 }
 
 // IsValid reports whether validator passed without errors
@@ -47,10 +64,17 @@ func (ve *ValidationErrors) Errors() map[string][]string {
 	return ve.errors
 }
 
-// FirstError returns the first validator error, if any
+// Fields returns the fields with errors in the order their first error was added
+func (ve *ValidationErrors) Fields() []string {
+	fields := make([]string, len(ve.fields))
+	copy(fields, ve.fields)
+	return fields
+}
+
+// FirstError returns the first validator error, if any, following field order
 func (ve *ValidationErrors) FirstError() string {
-	for _, fieldErrors := range ve.errors {
-		if len(fieldErrors) > 0 {
+	for _, field := range ve.fields {
+		if fieldErrors := ve.errors[field]; len(fieldErrors) > 0 {
 			return fieldErrors[0]
 		}
 	}
@@ -71,6 +95,39 @@ func (ve *ValidationErrors) HasFieldError(field string) bool {
 	return exists && len(errors) > 0
 }
 
+// Failures returns a copy of every failure in the order they were recorded
+func (ve *ValidationErrors) Failures() []FieldFailure {
+	failures := make([]FieldFailure, len(ve.failures))
+	copy(failures, ve.failures)
+	return failures
+}
+
+// FieldFailures returns the failures recorded for a specific field
+func (ve *ValidationErrors) FieldFailures(field string) []FieldFailure {
+	var failures []FieldFailure
+	for _, failure := range ve.failures {
+		if failure.Field == field {
+			failures = append(failures, failure)
+		}
+	}
+	return failures
+}
+
+// Redact removes the offending values of the given fields, or of every field when none are given,
+// so that failures can be logged or returned to clients without leaking sensitive input
+func (ve *ValidationErrors) Redact(fields ...string) {
+	redact := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		redact[field] = true
+	}
+	for i := range ve.failures {
+		if len(fields) == 0 || redact[ve.failures[i].Field] {
+			ve.failures[i].Value = nil
+			ve.failures[i].Redacted = true
+		}
+	}
+}
+
 // Error implements the error interface
 func (ve *ValidationErrors) Error() string {
 	return ve.FirstError()
@@ -28,3 +28,62 @@ func TestValidationErrorsBasic(t *testing.T) {
 		t.Fatal("expected age to be present in map")
 	}
 }
+
+func TestValidationErrorsFieldOrder(t *testing.T) {
+	ve := NewValidationErrors()
+	ve.AddError("zeta", "first")
+	ve.AddError("alpha", "second")
+	ve.AddError("zeta", "third")
+
+	fields := ve.Fields()
+	if len(fields) != 2 || fields[0] != "zeta" || fields[1] != "alpha" {
+		t.Fatalf("unexpected field order: %v", fields)
+	}
+	for i := 0; i < 10; i++ {
+		if ve.FirstError() != "first" || ve.Error() != "first" {
+			t.Fatalf("expected stable first error, got %q", ve.FirstError())
+		}
+	}
+
+	// Fields returns a copy
+	fields[0] = "mutated"
+	if ve.Fields()[0] != "zeta" {
+		t.Fatal("expected Fields to return a copy")
+	}
+}
+
+func TestSortedFieldRules(t *testing.T) {
+	ordered := SortedFieldRules(map[string]string{"b": "required", "a": "email", "c": "min:1"})
+	if len(ordered) != 3 || ordered[0].Field != "a" || ordered[1].Field != "b" || ordered[2].Field != "c" {
+		t.Fatalf("unexpected order: %v", ordered)
+	}
+	if ordered[0].Rules != "email" {
+		t.Fatalf("unexpected rules: %v", ordered[0])
+	}
+}
+
+func TestValidationErrorsFailures(t *testing.T) {
+	ve := NewValidationErrors()
+	ve.AddFailure(FieldFailure{
+		Field: "password", Rule: "min", Params: []string{"8"}, Value: "abc",
+		Code: "validation.min.string", Message: "too short",
+	})
+	ve.AddError("name", "is required")
+
+	if ve.FieldError("password") != "too short" || ve.FieldError("name") != "is required" {
+		t.Fatalf("expected messages in the Errors view, got %v", ve.Errors())
+	}
+	failures := ve.Failures()
+	if len(failures) != 2 || failures[0].Rule != "min" || failures[1].Field != "name" {
+		t.Fatalf("unexpected failures: %+v", failures)
+	}
+
+	ve.Redact("password")
+	redacted := ve.FieldFailures("password")
+	if len(redacted) != 1 || redacted[0].Value != nil || !redacted[0].Redacted {
+		t.Fatalf("expected password value to be redacted, got %+v", redacted)
+	}
+	if failures[0].Value != "abc" {
+		t.Fatal("expected Failures to return a copy")
+	}
+}
@@ -1,5 +1,7 @@
 package contract
 
+import "context"
+
 // Rule definitions with their corresponding error messages
 var (
 	// Acceptance rules
@@ -186,6 +188,15 @@ type Rule interface {
 	Validate(ctx RuleContext) error
 }
 
+// ImplicitRule is an optional interface for rules that must run even when the field
+// is missing or empty. Rules registered as implicit in the Registry behave the same way.
+type ImplicitRule interface {
+	Rule
+
+	// Implicit reports whether the rule runs for missing or empty values
+	Implicit() bool
+}
+
 // RuleContext provides validator context data for rules.
 type RuleContext interface {
 	// Field returns the field name being validated
@@ -202,6 +213,9 @@ type RuleContext interface {
 
 	// Attribute returns custom field name for messages
 	Attribute(field string) string
+
+	// Context returns the request context, used for cancellation and request-scoped values
+	Context() context.Context
 }
 
 // RuleFactory creates validator rules.
@@ -220,3 +234,15 @@ type RuleRegistry interface {
 	List() []string
 	Clear()
 }
+
+// Prefetcher is an optional interface for rules whose checks can be batched, such as database lookups.
+// Before validating, the engine collects the values of every field whose rules share a PrefetchKey,
+// expanding slice values into their elements, and calls Prefetch once per key. The returned context is
+// passed to all rules through RuleContext.Context. When Prefetch fails, rules check values one by one.
+type Prefetcher interface {
+	// PrefetchKey groups rules that can be prefetched together, e.g. "exists:products,id"
+	PrefetchKey() string
+
+	// Prefetch loads the outcome for values and returns a context carrying it
+	Prefetch(ctx context.Context, values []any) (context.Context, error)
+}
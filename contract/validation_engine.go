@@ -1,5 +1,10 @@
 package contract
 
+import (
+	"context"
+	"time"
+)
+
 // ValidationEngine is the abstraction the validator facade depends on.
 // It enables swapping the underlying engine implementation without changing
 // the facade or its consumers (DIP / code-to-interfaces).
@@ -7,9 +12,36 @@ type ValidationEngine interface {
 	// Execute validates data against the provided rules and returns a Result.
 	Execute(data DataProvider, rules map[string]string) Result
 
+	// ExecuteContext is like Execute but passes ctx to rules and verifiers and stops early,
+	// returning an error wrapping ErrValidationCanceled, once ctx is done.
+	ExecuteContext(ctx context.Context, data DataProvider, rules map[string]string) (Result, error)
+
+	// ExecuteOrdered is like ExecuteContext but validates fields, and reports their errors,
+	// in the order given by rules.
+	ExecuteOrdered(ctx context.Context, data DataProvider, rules []FieldRules) (Result, error)
+
+	// Compile parses rules and instantiates their rule objects once, returning an error
+	// for unknown rules or invalid parameters.
+	Compile(rules []FieldRules) (CompiledRules, error)
+
+	// ExecuteCompiled is like ExecuteOrdered but runs rules previously returned by Compile.
+	ExecuteCompiled(ctx context.Context, data DataProvider, rules CompiledRules) (Result, error)
+
 	// RegisterRule registers a new rule.
 	RegisterRule(name string, creator RuleCreator) error
 
+	// RegisterImplicitRule registers a rule that runs even when the field is missing or empty.
+	RegisterImplicitRule(name string, creator RuleCreator) error
+
+	// SetConcurrency sets how many fields are validated in parallel; values below 2 validate sequentially.
+	SetConcurrency(workers int)
+
+	// SetClock sets the clock rules read the current time from for relative dates such as "today".
+	SetClock(clock Clock)
+
+	// SetLocation sets the timezone of dates without a zone and of relative dates.
+	SetLocation(loc *time.Location)
+
 	// GetRegistry exposes the rule registry (read-only usage by facade).
 	GetRegistry() Registry
 
@@ -0,0 +1,144 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/parser"
+	"github.com/next-trace/scg-validator/utils"
+)
+
+// compiledRule is a parsed rule together with its instantiated rule object
+type compiledRule struct {
+	name    string
+	params  []string
+	rule    contract.Rule
+	creator contract.RuleCreator
+	// perElement marks rules whose parameters contain wildcards; they are created per element
+	perElement bool
+	unknown    bool
+	createErr  error
+}
+
+// compiledField holds the compiled rules of a single field pattern
+type compiledField struct {
+	field     string
+	rules     []compiledRule
+	bail      bool
+	sometimes bool
+	nullable  bool
+	// numeric reports whether the field has a numeric rule, so that size rules measure numeric
+	// strings by value
+	numeric bool
+	// prefetch reports whether any rule implements contract.Prefetcher
+	prefetch bool
+}
+
+// CompiledRules is a rules input whose rule strings have been parsed and whose rule objects
+// have been instantiated once. It holds no per-call state and is safe for concurrent use.
+type CompiledRules struct {
+	fields []compiledField
+}
+
+// Ensure CompiledRules implements contract.CompiledRules
+var _ contract.CompiledRules = (*CompiledRules)(nil)
+
+// Fields returns the compiled field patterns in declaration order
+func (c *CompiledRules) Fields() []string {
+	fields := make([]string, len(c.fields))
+	for i, cf := range c.fields {
+		fields[i] = cf.field
+	}
+	return fields
+}
+
+// Compile parses the rules and instantiates every rule object once so that the result can be
+// executed repeatedly with ExecuteCompiled. Unknown rules and invalid parameters are returned
+// as errors wrapping contract.ErrRuleNotFound and contract.ErrInvalidRule.
+func (e *Engine) Compile(fieldRules []contract.FieldRules) (contract.CompiledRules, error) {
+	compiled := e.compile(fieldRules)
+
+	var errs []error
+	for _, cf := range compiled.fields {
+		for _, cr := range cf.rules {
+			switch {
+			case cr.unknown:
+				errs = append(errs, fmt.Errorf("field %q: %w: %s", cf.field, contract.ErrRuleNotFound, cr.name))
+			case cr.createErr != nil:
+				errs = append(errs, fmt.Errorf("field %q: %w %s: %w",
+					cf.field, contract.ErrInvalidRule, cr.name, cr.createErr))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return compiled, nil
+}
+
+// compile builds the compiled form of fieldRules. Unknown rules and creation errors are kept on
+// the compiled rule so that the string path can report them as field errors.
+func (e *Engine) compile(fieldRules []contract.FieldRules) *CompiledRules {
+	compiled := &CompiledRules{fields: make([]compiledField, 0, len(fieldRules))}
+	for _, fr := range fieldRules {
+		compiled.fields = append(compiled.fields, e.compileField(fr))
+	}
+	return compiled
+}
+
+// compileField parses the rule string of a single field and creates its rule objects
+func (e *Engine) compileField(fr contract.FieldRules) compiledField {
+	parsedRules := parser.ParseRules(fr.Rules)
+	cf := compiledField{
+		field:     fr.Field,
+		rules:     make([]compiledRule, 0, len(parsedRules)),
+		bail:      hasRule(parsedRules, BailRuleName),
+		sometimes: hasRule(parsedRules, SometimesRuleName),
+		nullable:  hasRule(parsedRules, NullableRuleName),
+		numeric:   e.hasNumericRule(parsedRules),
+	}
+	fieldHasWildcard := utils.HasWildcard(fr.Field)
+
+	for _, parsedRule := range parsedRules {
+		if isControlRule(parsedRule.Name) {
+			continue
+		}
+
+		// Aliases run under the canonical name, which keys failure codes and messages
+		cr := compiledRule{name: e.Registry.Canonical(parsedRule.Name), params: parsedRule.Params}
+		creator, exists := e.Registry.Get(cr.name)
+		if !exists {
+			cr.unknown = true
+			cf.rules = append(cf.rules, cr)
+			continue
+		}
+		cr.creator = creator
+
+		// Create the rule once even when it is re-created per element, to surface bad parameters
+		rule, err := creator(parsedRule.Params)
+		switch {
+		case err != nil:
+			cr.createErr = err
+		case fieldHasWildcard && hasWildcardParam(parsedRule.Params):
+			cr.perElement = true
+		default:
+			cr.rule = rule
+			_, isPrefetcher := rule.(contract.Prefetcher)
+			cf.prefetch = cf.prefetch || isPrefetcher
+		}
+		cf.rules = append(cf.rules, cr)
+	}
+
+	return cf
+}
+
+// hasWildcardParam reports whether any rule parameter is a path containing a "*" segment
+func hasWildcardParam(params []string) bool {
+	for _, param := range params {
+		if utils.HasWildcard(param) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,76 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+func TestEngine_Compile(t *testing.T) {
+	e := NewEngine()
+	created := 0
+	_ = e.RegisterRule("counting", func(_ []string) (contract.Rule, error) {
+		created++
+		return &ctxRecordingRule{seen: new(context.Context)}, nil
+	})
+
+	compiled, err := e.Compile([]contract.FieldRules{
+		{Field: "b", Rules: "bail|counting"},
+		{Field: "a", Rules: "counting"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected compile error: %v", err)
+	}
+	if fields := compiled.Fields(); len(fields) != 2 || fields[0] != "b" || fields[1] != "a" {
+		t.Fatalf("unexpected fields: %v", fields)
+	}
+
+	data := NewDataProvider(map[string]any{"a": "x", "b": "y"})
+	for i := 0; i < 3; i++ {
+		if _, err := e.ExecuteCompiled(context.Background(), data, compiled); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if created != 2 {
+		t.Fatalf("expected rules to be created once per field, got %d creations", created)
+	}
+}
+
+func TestEngine_CompileErrors(t *testing.T) {
+	e := NewEngine()
+	_, err := e.Compile([]contract.FieldRules{
+		{Field: "a", Rules: "nonexistent"},
+		{Field: "b", Rules: "accepted_if:status"},
+	})
+	if !errors.Is(err, contract.ErrRuleNotFound) || !errors.Is(err, contract.ErrInvalidRule) {
+		t.Fatalf("expected both compile errors, got %v", err)
+	}
+
+	// The string path keeps reporting them as field errors
+	res := e.Execute(NewDataProvider(map[string]any{}), map[string]string{"a": "nonexistent"})
+	if got := res.FieldError("a"); got != UnknownRuleErrorMsg+"nonexistent" {
+		t.Fatalf("unexpected message: %q", got)
+	}
+}
+
+func TestEngine_ExecuteCompiled_WildcardParams(t *testing.T) {
+	e := NewEngine()
+	compiled, err := e.Compile([]contract.FieldRules{{Field: "items.*.price", Rules: "required_if:items.*.type,digital"}})
+	if err != nil {
+		t.Fatalf("unexpected compile error: %v", err)
+	}
+
+	data := NewDataProvider(map[string]any{"items": []any{
+		map[string]any{"type": "physical"},
+		map[string]any{"type": "digital"},
+	}})
+	res, err := e.ExecuteCompiled(context.Background(), data, compiled)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.HasFieldError("items.0.price") || !res.HasFieldError("items.1.price") {
+		t.Fatalf("unexpected errors: %v", res.Errors())
+	}
+}
@@ -1,15 +1,26 @@
 package engine
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/message"
 	"github.com/next-trace/scg-validator/parser"
 	"github.com/next-trace/scg-validator/rules"
+	"github.com/next-trace/scg-validator/utils"
 )
 
 // Define constants to avoid magic strings and magic numbers
 const (
 	BailRuleName         = "bail"
+	SometimesRuleName    = "sometimes"
+	NullableRuleName     = "nullable"
 	UnknownRuleErrorMsg  = "Unknown rule: "
 	RuleCreationErrorMsg = "Rule creation error: "
 )
@@ -18,6 +29,12 @@ const (
 type Engine struct {
 	Registry        contract.Registry
 	MessageResolver contract.MessageResolver
+	// Concurrency is the number of fields validated in parallel; values below 2 validate sequentially
+	Concurrency int
+	// Clock and Location are the current time and timezone that rules use for relative dates such
+	// as "today"; a clock or location already carried by the request context takes precedence
+	Clock    contract.Clock
+	Location *time.Location
 }
 
 // Ensure Engine implements contract.ValidationEngine
@@ -28,100 +45,476 @@ func NewEngine() *Engine {
 	// Create a new registry with all default rules using options pattern
 	reg := rules.NewRuleRegistry()
 
-	return &Engine{
-		Registry:        reg,
-		MessageResolver: message.NewRequestScopedResolver(),
-	}
+	e := &Engine{Registry: reg}
+	e.SetMessageResolver(message.NewResolver())
+	return e
 }
 
 // Execute validates data against the provided rules
 func (e *Engine) Execute(data contract.DataProvider, rulesMap map[string]string) contract.Result {
+	result, _ := e.ExecuteContext(context.Background(), data, rulesMap)
+	return result
+}
+
+// ExecuteContext validates data against the provided rules, making ctx available to rules and
+// verifiers. Fields are validated in sorted order so that results are deterministic.
+// Validation stops early once ctx is done; the returned error then wraps
+// contract.ErrValidationCanceled and the context error, and the result holds the errors found so far.
+func (e *Engine) ExecuteContext(
+	ctx context.Context,
+	data contract.DataProvider,
+	rulesMap map[string]string,
+) (contract.Result, error) {
+	return e.ExecuteOrdered(ctx, data, contract.SortedFieldRules(rulesMap))
+}
+
+// ExecuteOrdered validates data against an ordered rules input. Fields are validated, and their
+// errors reported, in declaration order; wildcard fields expand in element order.
+// Unknown rules and rule creation errors are reported as field errors.
+func (e *Engine) ExecuteOrdered(
+	ctx context.Context,
+	data contract.DataProvider,
+	fieldRules []contract.FieldRules,
+) (contract.Result, error) {
+	return e.execute(ctx, data, e.compile(fieldRules).fields)
+}
+
+// ExecuteCompiled validates data against rules returned by Compile, skipping rule parsing and
+// rule creation. It is safe to call concurrently with the same compiled rules.
+func (e *Engine) ExecuteCompiled(
+	ctx context.Context,
+	data contract.DataProvider,
+	compiled contract.CompiledRules,
+) (contract.Result, error) {
+	rules, ok := compiled.(*CompiledRules)
+	if !ok {
+		err := fmt.Errorf("%w: unsupported compiled rules %T", contract.ErrInvalidRule, compiled)
+		return contract.NewValidationErrors(), err
+	}
+	return e.execute(ctx, data, rules.fields)
+}
+
+// fieldTask is a concrete field path to validate against a compiled field
+type fieldTask struct {
+	cf      *compiledField
+	path    string
+	indices []string
+}
+
+// execute runs the compiled fields against data in order
+func (e *Engine) execute(
+	ctx context.Context,
+	data contract.DataProvider,
+	fields []compiledField,
+) (contract.Result, error) {
+	ctx = e.timeContext(ctx)
+	tasks := expandTasks(data, fields)
+	ctx = prefetch(ctx, data, tasks)
+	if e.Concurrency > 1 && len(tasks) > 1 {
+		return e.executeConcurrent(ctx, data, tasks)
+	}
+
+	validationErrors := contract.NewValidationErrors()
+	for _, task := range tasks {
+		if err := e.validateField(ctx, task.cf, task.path, task.indices, data, validationErrors); err != nil {
+			return validationErrors, err
+		}
+	}
+
+	return validationErrors, nil
+}
+
+// executeConcurrent validates tasks on a pool of e.Concurrency workers. Each field collects its
+// own errors, which are merged in task order so the result matches sequential validation.
+func (e *Engine) executeConcurrent(
+	ctx context.Context,
+	data contract.DataProvider,
+	tasks []fieldTask,
+) (contract.Result, error) {
+	results := make([]*contract.ValidationErrors, len(tasks))
+	errs := make([]error, len(tasks))
+
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(e.Concurrency, len(tasks)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				task := tasks[i]
+				results[i] = contract.NewValidationErrors()
+				errs[i] = e.validateField(ctx, task.cf, task.path, task.indices, data, results[i])
+			}
+		}()
+	}
+
+feed:
+	for i := range tasks {
+		select {
+		case next <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(next)
+	wg.Wait()
+
 	validationErrors := contract.NewValidationErrors()
+	for i := range tasks {
+		// Tasks that were never started because ctx is done end the result
+		if results[i] == nil {
+			return validationErrors, contract.NewCanceledError(ctx.Err())
+		}
+		for _, failure := range results[i].Failures() {
+			validationErrors.AddFailure(failure)
+		}
+		if errs[i] != nil {
+			return validationErrors, errs[i]
+		}
+	}
+
+	return validationErrors, nil
+}
+
+// prefetchGroup collects the values of all rules sharing a prefetch key
+type prefetchGroup struct {
+	prefetcher contract.Prefetcher
+	values     []any
+}
+
+// prefetch lets rules implementing contract.Prefetcher load the values of every field they validate
+// with a single call per prefetch key, and returns the context passed to the rules
+func prefetch(ctx context.Context, data contract.DataProvider, tasks []fieldTask) context.Context {
+	var keys []string
+	groups := make(map[string]*prefetchGroup)
+
+	for _, task := range tasks {
+		if !task.cf.prefetch {
+			continue
+		}
+		value, present := lookupValue(data, task.path)
+		if !present || value == nil || isEmptyString(value) {
+			continue
+		}
 
-	// Iterate over each field and corresponding rules
-	for field, ruleString := range rulesMap {
-		e.validateField(field, ruleString, data, validationErrors)
+		for _, cr := range task.cf.rules {
+			prefetcher, ok := cr.rule.(contract.Prefetcher)
+			if !ok {
+				continue
+			}
+			key := prefetcher.PrefetchKey()
+			group, exists := groups[key]
+			if !exists {
+				group = &prefetchGroup{prefetcher: prefetcher}
+				groups[key] = group
+				keys = append(keys, key)
+			}
+			group.values = append(group.values, prefetchValues(value)...)
+		}
 	}
 
-	return validationErrors
+	for _, key := range keys {
+		group := groups[key]
+		// When prefetching fails the rules fall back to checking values one by one
+		if next, err := group.prefetcher.Prefetch(ctx, group.values); err == nil && next != nil {
+			ctx = next
+		}
+	}
+	return ctx
+}
+
+// prefetchValues expands slice and array values into their elements
+func prefetchValues(value any) []any {
+	val := reflect.ValueOf(value)
+	if val.Kind() != reflect.Slice && val.Kind() != reflect.Array {
+		return []any{value}
+	}
+	values := make([]any, val.Len())
+	for i := range values {
+		values[i] = val.Index(i).Interface()
+	}
+	return values
+}
+
+// expandTasks lists the concrete field paths to validate, expanding wildcard fields in element order
+func expandTasks(data contract.DataProvider, fields []compiledField) []fieldTask {
+	tasks := make([]fieldTask, 0, len(fields))
+	for i := range fields {
+		cf := &fields[i]
+		if !utils.HasWildcard(cf.field) {
+			tasks = append(tasks, fieldTask{cf: cf, path: cf.field})
+			continue
+		}
+
+		// Expand wildcard paths so that each concrete element gets its own errors
+		for _, path := range utils.ExpandPath(data.All(), cf.field) {
+			tasks = append(tasks, fieldTask{cf: cf, path: path, indices: utils.WildcardIndices(cf.field, path)})
+		}
+	}
+	return tasks
 }
 
-// validateField validates a single field against its rules
+// validateField validates a single field against its compiled rules.
+// indices holds the values matched by wildcards in the field pattern and is used to resolve
+// wildcard parameters (e.g. "required_if:items.*.type,digital") relative to the same element.
+// It returns an error only when ctx is done.
 func (e *Engine) validateField(
-	field, ruleString string,
+	ctx context.Context,
+	cf *compiledField,
+	field string,
+	indices []string,
 	data contract.DataProvider,
 	validationErrors *contract.ValidationErrors,
-) {
-	parsedRules := parser.ParseRules(ruleString)
-	value, _ := data.Get(field)
+) error {
+	value, present := lookupValue(data, field)
 	allData := data.All()
 
-	stopOnFailure := e.shouldStopOnFailure(parsedRules)
+	// sometimes: only validate the field when it is present in the input
+	if !present && cf.sometimes {
+		return nil
+	}
 
-	for _, parsedRule := range parsedRules {
-		if parsedRule.Name == BailRuleName {
-			continue
+	for _, cr := range cf.rules {
+		// Stop before running further rules once the request is canceled or timed out
+		if err := ctx.Err(); err != nil {
+			return contract.NewCanceledError(err)
 		}
 
-		if e.validateSingleRule(field, value, parsedRule, allData, validationErrors) && stopOnFailure {
+		state := fieldState{ctx: ctx, value: value, present: present, nullable: cf.nullable, numeric: cf.numeric}
+		if e.validateSingleRule(field, indices, state, cr, allData, validationErrors) && cf.bail {
 			break
 		}
 	}
+
+	if err := ctx.Err(); err != nil {
+		return contract.NewCanceledError(err)
+	}
+	return nil
 }
 
-// shouldStopOnFailure checks if the bail rule is present in the parsed rules
-func (e *Engine) shouldStopOnFailure(parsedRules []parser.ParsedRule) bool {
+// shouldRunRule applies the implicit-rule model: implicit rules always run, while other rules
+// are skipped when the field is missing, holds an empty string, or is nil and marked nullable.
+func (e *Engine) shouldRunRule(ruleName string, rule contract.Rule, state fieldState) bool {
+	if e.isImplicit(ruleName, rule) {
+		return true
+	}
+	if !state.present || isEmptyString(state.value) {
+		return false
+	}
+	return state.value != nil || !state.nullable
+}
+
+// isImplicit reports whether a rule was registered as implicit or declares itself implicit
+func (e *Engine) isImplicit(ruleName string, rule contract.Rule) bool {
+	if e.Registry.IsImplicit(ruleName) {
+		return true
+	}
+	implicitRule, ok := rule.(contract.ImplicitRule)
+	return ok && implicitRule.Implicit()
+}
+
+// hasRule checks if a rule with the given name is present in the parsed rules
+func hasRule(parsedRules []parser.ParsedRule, name string) bool {
+	for _, rule := range parsedRules {
+		if rule.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// numericRules make size rules measure the numeric strings of their field by value, as in Laravel
+var numericRules = map[string]bool{rules.RuleNumeric: true, rules.RuleInteger: true, rules.RuleDecimal: true}
+
+// hasNumericRule reports whether any of the parsed rules, or the rule an alias names, is a numeric rule
+func (e *Engine) hasNumericRule(parsedRules []parser.ParsedRule) bool {
 	for _, rule := range parsedRules {
-		if rule.Name == BailRuleName {
+		if numericRules[e.Registry.Canonical(rule.Name)] {
 			return true
 		}
 	}
 	return false
 }
 
-// validateSingleRule validates a single rule and returns true if validation failed
+// sizeValue returns the value size rules measure: numeric strings of fields with a numeric rule
+// become json.Number, so that they compare, pick their message and report their code as numbers
+func sizeValue(ruleName string, state fieldState) any {
+	s, ok := state.value.(string)
+	if !ok || !state.numeric || !contract.IsSizeRule(ruleName) {
+		return state.value
+	}
+	if _, err := utils.ParseNumber(s); err != nil {
+		return state.value
+	}
+	return json.Number(s)
+}
+
+// isControlRule reports whether the rule is a directive handled by the engine itself
+func isControlRule(name string) bool {
+	return name == BailRuleName || name == SometimesRuleName || name == NullableRuleName
+}
+
+// isEmptyString reports whether value is a string containing only whitespace
+func isEmptyString(value any) bool {
+	s, ok := value.(string)
+	return ok && strings.TrimSpace(s) == ""
+}
+
+// fieldState describes the value of the field being validated
+type fieldState struct {
+	ctx      context.Context
+	value    any
+	present  bool
+	nullable bool
+	numeric  bool
+}
+
+// validateSingleRule validates a single compiled rule and returns true if validation failed
 func (e *Engine) validateSingleRule(
 	field string,
-	value interface{},
-	parsedRule parser.ParsedRule,
+	indices []string,
+	state fieldState,
+	cr compiledRule,
 	allData map[string]interface{},
 	validationErrors *contract.ValidationErrors,
 ) bool {
-	ruleName := parsedRule.Name
+	ruleName := cr.name
 
-	// Fetch the rule creator from the registry
-	ruleCreator, exists := e.Registry.Get(ruleName)
-	if !exists {
-		validationErrors.AddError(field, UnknownRuleErrorMsg+ruleName)
-		return true
+	rule, params := cr.rule, cr.params
+	if cr.perElement {
+		// Wildcard parameters resolve against the element being validated
+		params = replaceWildcardParams(params, indices)
 	}
 
-	// Create the rule and handle any errors during creation
-	rule, err := ruleCreator(parsedRule.Params)
-	if err != nil {
-		validationErrors.AddError(field, RuleCreationErrorMsg+err.Error())
+	failure := contract.FieldFailure{Field: field, Rule: ruleName, Params: params, Value: state.value}
+	switch {
+	case cr.unknown:
+		failure.Code, failure.Message = contract.CodeUnknownRule, UnknownRuleErrorMsg+ruleName
+	case cr.createErr != nil:
+		failure.Code, failure.Message = contract.CodeInvalidRule, RuleCreationErrorMsg+cr.createErr.Error()
+	}
+	if failure.Code != "" {
+		validationErrors.AddFailure(failure)
 		return true
 	}
 
+	if cr.perElement {
+		var err error
+		if rule, err = cr.creator(params); err != nil {
+			failure.Code, failure.Message = contract.CodeInvalidRule, RuleCreationErrorMsg+err.Error()
+			validationErrors.AddFailure(failure)
+			return true
+		}
+	}
+
+	// Skip non-implicit rules for missing, empty or nullable values
+	if !e.shouldRunRule(ruleName, rule, state) {
+		return false
+	}
+
 	// Create validation context and perform the validation
-	ctx := contract.NewValidationContext(field, value, parsedRule.Params, allData)
+	value := sizeValue(ruleName, state)
+	ctx := contract.NewValidationContext(field, value, params, allData)
+	ctx.SetContext(state.ctx)
 
 	// Validate and handle error if validation fails
 	if err := rule.Validate(ctx); err != nil {
-		errorMessage := e.resolveErrorMessage(ruleName, field, parsedRule.Params, err)
-		validationErrors.AddError(field, errorMessage)
+		// A rule failing because the request was canceled is not a field error
+		if state.ctx.Err() != nil {
+			return false
+		}
+		failure.Attribute = e.attributeName(field)
+		// Rules reporting typed reasons get one failure per reason
+		if reasons := contract.ReasonsOf(err); len(reasons) > 0 {
+			e.addReasonFailures(failure, value, reasons, validationErrors)
+			return true
+		}
+		failure.Code = contract.FailureCode(ruleName, value)
+		failure.Message = e.resolveErrorMessage(ruleName, field, value, params, err)
+		failure.Template = e.messageTemplate(failure, value, errorReason(ruleName, err))
+		validationErrors.AddFailure(failure)
 		return true
 	}
 
 	return false
 }
 
-// resolveErrorMessage resolves the error message using the message resolver
-func (e *Engine) resolveErrorMessage(ruleName, field string, params []string, originalError error) string {
-	if e.MessageResolver != nil {
-		return e.MessageResolver.Resolve(ruleName, field, params)
+// resolveErrorMessage resolves the error message using the message resolver. Resolvers that
+// localize reasons fall back to the rule's error text before their generic message.
+func (e *Engine) resolveErrorMessage(ruleName, field string, value any, params []string, originalError error) string {
+	return e.resolveReasonMessage(ruleName, field, value, params, *errorReason(ruleName, originalError))
+}
+
+// errorReason wraps the plain error of a rule in a reason keyed by the rule itself, so that the
+// messages defined for the rule apply and the error text is used only when there are none
+func errorReason(ruleName string, err error) *contract.Reason {
+	reason := contract.NewReason(ruleName, err.Error(), nil)
+	return &reason
+}
+
+// attributeName returns the attribute name the resolver uses for field, or "" when it does not report it
+func (e *Engine) attributeName(field string) string {
+	if resolver, ok := e.MessageResolver.(contract.AttributeResolver); ok {
+		return resolver.Attribute(field)
+	}
+	return ""
+}
+
+// messageTemplate returns the message of failure without the attribute name, or "" when the
+// resolver does not render templates
+func (e *Engine) messageTemplate(failure contract.FieldFailure, value any, reason *contract.Reason) string {
+	if resolver, ok := e.MessageResolver.(contract.TemplateResolver); ok {
+		return resolver.Template(failure.Rule, failure.Field, value, failure.Params, reason)
+	}
+	return ""
+}
+
+// addReasonFailures records a failure for each reason reported by a rule on the measured value
+func (e *Engine) addReasonFailures(
+	failure contract.FieldFailure,
+	value any,
+	reasons []contract.Reason,
+	validationErrors *contract.ValidationErrors,
+) {
+	for _, reason := range reasons {
+		reasonFailure := failure
+		reasonFailure.Reason, reasonFailure.Code = reason.Key, contract.ReasonCode(reason)
+		reasonFailure.Message = e.resolveReasonMessage(failure.Rule, failure.Field, value, failure.Params, reason)
+		reasonFailure.Template = e.messageTemplate(failure, value, &reason)
+		validationErrors.AddFailure(reasonFailure)
 	}
-	return originalError.Error()
+}
+
+// resolveReasonMessage resolves the message of a typed failure reason. Resolvers that do not
+// localize reasons render the rule's message, as for any other failure.
+func (e *Engine) resolveReasonMessage(
+	ruleName, field string, value any, params []string, reason contract.Reason,
+) string {
+	switch resolver := e.MessageResolver.(type) {
+	case contract.ReasonResolver:
+		return resolver.ResolveReason(ruleName, field, value, params, reason)
+	case nil:
+		return reason.Message
+	default:
+		return resolver.Resolve(ruleName, field, value, params)
+	}
+}
+
+// lookupValue retrieves a field value from the data provider, resolving dot-notation paths
+func lookupValue(data contract.DataProvider, field string) (any, bool) {
+	if value, exists := data.Get(field); exists {
+		return value, true
+	}
+	return utils.GetPath(data.All(), field)
+}
+
+// replaceWildcardParams resolves "*" segments in rule parameters using the wildcard indices
+func replaceWildcardParams(params []string, indices []string) []string {
+	resolved := make([]string, len(params))
+	for i, param := range params {
+		resolved[i] = utils.ReplaceWildcards(param, indices)
+	}
+	return resolved
 }
 
 // RegisterRule registers a new rule with the engine
@@ -129,8 +522,17 @@ func (e *Engine) RegisterRule(name string, creator contract.RuleCreator) error {
 	return e.Registry.Register(name, creator)
 }
 
-// SetMessageResolver sets a custom message resolver
+// RegisterImplicitRule registers a rule that runs even when the field is missing or empty
+func (e *Engine) RegisterImplicitRule(name string, creator contract.RuleCreator) error {
+	return e.Registry.RegisterImplicit(name, creator)
+}
+
+// SetMessageResolver sets a custom message resolver. Resolvers implementing
+// contract.DescriptorResolver get the engine's registry to render named placeholders.
 func (e *Engine) SetMessageResolver(resolver contract.MessageResolver) {
+	if dr, ok := resolver.(contract.DescriptorResolver); ok && e.Registry != nil {
+		dr.SetRuleDescriber(e.Registry)
+	}
 	e.MessageResolver = resolver
 }
 
@@ -148,6 +550,33 @@ func (e *Engine) SetCustomAttribute(field string, attribute string) {
 	}
 }
 
+// SetConcurrency sets how many fields are validated in parallel; values below 2 validate sequentially.
+// Errors are reported in the same order either way and bail still applies within each field.
+func (e *Engine) SetConcurrency(workers int) {
+	e.Concurrency = workers
+}
+
+// SetClock sets the clock rules read the current time from, e.g. contract.FixedClock in tests
+func (e *Engine) SetClock(clock contract.Clock) {
+	e.Clock = clock
+}
+
+// SetLocation sets the timezone of dates without a zone and of relative dates such as "today"
+func (e *Engine) SetLocation(loc *time.Location) {
+	e.Location = loc
+}
+
+// timeContext adds the engine's clock and timezone to ctx unless it already carries its own
+func (e *Engine) timeContext(ctx context.Context) context.Context {
+	if _, ok := contract.ClockFrom(ctx); !ok && e.Clock != nil {
+		ctx = contract.WithClock(ctx, e.Clock)
+	}
+	if _, ok := contract.LocationFrom(ctx); !ok && e.Location != nil {
+		ctx = contract.WithLocation(ctx, e.Location)
+	}
+	return ctx
+}
+
 // GetRegistry exposes the rule registry
 func (e *Engine) GetRegistry() contract.Registry {
 	return e.Registry
@@ -160,10 +589,14 @@ func (e *Engine) GetMessageResolver() contract.MessageResolver {
 
 // CloneWithResolver creates a new Engine that shares the same registry but uses the provided resolver
 func (e *Engine) CloneWithResolver(resolver contract.MessageResolver) contract.ValidationEngine {
-	return &Engine{
-		Registry:        e.Registry,
-		MessageResolver: resolver,
+	clone := &Engine{
+		Registry:    e.Registry,
+		Concurrency: e.Concurrency,
+		Clock:       e.Clock,
+		Location:    e.Location,
 	}
+	clone.SetMessageResolver(resolver)
+	return clone
 }
 
 // DataProvider implementation for map[strings]interface{}
@@ -176,22 +609,14 @@ func NewDataProvider(data map[string]interface{}) *DataProvider {
 	return &DataProvider{data: data}
 }
 
-// Get retrieves a value by key
+// Get retrieves a value by key, supporting dot-notation paths such as "address.city"
 func (d *DataProvider) Get(key string) (interface{}, bool) {
-	if d.data == nil {
-		return nil, false
-	}
-	value, exists := d.data[key]
-	return value, exists
+	return utils.GetPath(d.data, key)
 }
 
-// Has checks if a key exists
+// Has checks if a key exists, supporting dot-notation paths
 func (d *DataProvider) Has(key string) bool {
-	if d.data == nil {
-		return false
-	}
-	_, exists := d.data[key]
-	return exists
+	return utils.HasPath(d.data, key)
 }
 
 // All returns all data
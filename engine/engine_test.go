@@ -1,8 +1,12 @@
 package engine
 
 import (
+	"context"
 	"errors"
+	"reflect"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/next-trace/scg-validator/contract"
 )
@@ -14,12 +18,16 @@ func (r *alwaysFailRule) Validate(ctx contract.RuleContext) error {
 	return errors.New("custom error message")
 }
 
+type selfImplicitRule struct{ alwaysFailRule }
+
+func (r *selfImplicitRule) Implicit() bool { return true }
+
 func TestEngine_BailVsNoBail(t *testing.T) {
 	e := NewEngine()
-	data := NewDataProvider(map[string]any{"name": ""})
+	data := NewDataProvider(map[string]any{"name": "ab"})
 
 	// With bail -> only first failure should be recorded
-	res1 := e.Execute(data, map[string]string{"name": "bail|required|min:3"})
+	res1 := e.Execute(data, map[string]string{"name": "bail|min:3|email"})
 	if res1.IsValid() {
 		t.Fatalf("expected invalid result")
 	}
@@ -28,7 +36,7 @@ func TestEngine_BailVsNoBail(t *testing.T) {
 	}
 
 	// Without bail -> multiple failures should be recorded
-	res2 := e.Execute(data, map[string]string{"name": "required|min:3"})
+	res2 := e.Execute(data, map[string]string{"name": "min:3|email"})
 	if res2.IsValid() {
 		t.Fatalf("expected invalid result")
 	}
@@ -52,3 +60,361 @@ func TestEngine_ResolveErrorMessage_FallbackToOriginal(t *testing.T) {
 		t.Fatalf("unexpected message: %q", got)
 	}
 }
+
+func TestEngine_ResolveErrorMessage_RuleTextBeforeFallback(t *testing.T) {
+	e := NewEngine()
+	_ = e.RegisterRule("tenant", func(_ []string) (contract.Rule, error) { return &tenantRule{}, nil })
+	data := NewDataProvider(map[string]any{"a": "x"})
+
+	res := e.Execute(data, map[string]string{"a": "tenant"})
+	f := res.Failures()
+	if len(f) != 1 || f[0].Message != "need tenant" || f[0].Reason != "" || f[0].Code != "validation.tenant" {
+		t.Fatalf("expected the rule's error text, got %+v", f)
+	}
+	if f[0].Template != "need tenant" {
+		t.Errorf("unexpected template: %q", f[0].Template)
+	}
+
+	e.SetCustomMessage("tenant", "The :attribute needs a tenant")
+	if got := e.Execute(data, map[string]string{"a": "tenant"}).FieldError("a"); got != "The a needs a tenant" {
+		t.Errorf("custom message should win over the rule's text, got %q", got)
+	}
+}
+
+type tenantRule struct{}
+
+func (r *tenantRule) Name() string { return "tenant" }
+func (r *tenantRule) Validate(_ contract.RuleContext) error {
+	return errors.New("need tenant")
+}
+
+func TestEngine_NestedAndWildcardPaths(t *testing.T) {
+	e := NewEngine()
+	data := NewDataProvider(map[string]any{
+		"address": map[string]any{"city": ""},
+		"items": []any{
+			map[string]any{"price": 5, "type": "physical"},
+			map[string]any{"type": "digital"},
+			map[string]any{"price": 0, "type": "physical", "tags": []any{"ok", ""}},
+		},
+	})
+
+	res := e.Execute(data, map[string]string{
+		"address.city":   "required",
+		"items.*.price":  "required_if:items.*.type,digital|gt:1",
+		"items.*.tags.*": "required",
+	})
+
+	for _, field := range []string{"address.city", "items.1.price", "items.2.price", "items.2.tags.1"} {
+		if !res.HasFieldError(field) {
+			t.Errorf("expected error for %s, got %v", field, res.Errors())
+		}
+	}
+	for _, field := range []string{"items.0.price", "items.2.tags.0"} {
+		if res.HasFieldError(field) {
+			t.Errorf("did not expect error for %s: %v", field, res.Errors()[field])
+		}
+	}
+}
+
+func TestEngine_SometimesNullableAndImplicitRules(t *testing.T) {
+	e := NewEngine()
+	data := NewDataProvider(map[string]any{"nickname": nil, "bio": "", "website": nil})
+
+	res := e.Execute(data, map[string]string{
+		"email":    "sometimes|required|email", // missing -> skipped entirely
+		"nickname": "nullable|alpha",           // nil + nullable -> non-implicit rules skipped
+		"bio":      "min:10",                   // empty string -> non-implicit rules skipped
+		"age":      "integer",                  // missing -> non-implicit rules skipped
+		"website":  "url",                      // nil without nullable -> rule runs
+		"name":     "required|min:3",           // missing -> implicit rule runs
+	})
+
+	for _, field := range []string{"email", "nickname", "bio", "age"} {
+		if res.HasFieldError(field) {
+			t.Errorf("did not expect error for %s: %v", field, res.Errors()[field])
+		}
+	}
+	if !res.HasFieldError("website") {
+		t.Error("expected url to run on nil value without nullable")
+	}
+	if got := len(res.Errors()["name"]); got != 1 {
+		t.Errorf("expected only the implicit required error for name, got %v", res.Errors()["name"])
+	}
+
+	// sometimes still validates present fields
+	res = e.Execute(NewDataProvider(map[string]any{"email": "bad"}), map[string]string{"email": "sometimes|email"})
+	if !res.HasFieldError("email") {
+		t.Error("expected sometimes to validate present field")
+	}
+}
+
+func TestEngine_ImplicitRuleInterface(t *testing.T) {
+	e := NewEngine()
+	_ = e.RegisterRule("self_implicit", func(_ []string) (contract.Rule, error) { return &selfImplicitRule{}, nil })
+	_ = e.RegisterRule("custom_fail", func(_ []string) (contract.Rule, error) { return &alwaysFailRule{}, nil })
+	_ = e.RegisterImplicitRule("registered_implicit", func(_ []string) (contract.Rule, error) {
+		return &alwaysFailRule{}, nil
+	})
+
+	res := e.Execute(NewDataProvider(map[string]any{}), map[string]string{
+		"a": "self_implicit",
+		"b": "custom_fail",
+		"c": "registered_implicit",
+	})
+	if !res.HasFieldError("a") || !res.HasFieldError("c") {
+		t.Fatalf("expected implicit rules to run on missing fields, got %v", res.Errors())
+	}
+	if res.HasFieldError("b") {
+		t.Fatal("expected non-implicit rule to be skipped on missing field")
+	}
+}
+
+type ctxRecordingRule struct{ seen *context.Context }
+
+func (r *ctxRecordingRule) Name() string { return "ctx_recording" }
+func (r *ctxRecordingRule) Validate(ctx contract.RuleContext) error {
+	*r.seen = ctx.Context()
+	return nil
+}
+
+func TestEngine_ExecuteContext(t *testing.T) {
+	e := NewEngine()
+	var seen context.Context
+	_ = e.RegisterRule("ctx_recording", func(_ []string) (contract.Rule, error) {
+		return &ctxRecordingRule{seen: &seen}, nil
+	})
+
+	reqCtx := context.WithValue(context.Background(), ctxKey{}, "user-1")
+	data := NewDataProvider(map[string]any{"f": "v"})
+	res, err := e.ExecuteContext(reqCtx, data, map[string]string{"f": "ctx_recording"})
+	if err != nil || !res.IsValid() {
+		t.Fatalf("unexpected result: %v %v", res.Errors(), err)
+	}
+	if seen == nil || seen.Value(ctxKey{}) != "user-1" {
+		t.Fatal("expected rule to receive the request context")
+	}
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = e.ExecuteContext(canceled, NewDataProvider(map[string]any{"f": ""}), map[string]string{"f": "required"})
+	if !errors.Is(err, contract.ErrValidationCanceled) || !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected canceled error, got %v", err)
+	}
+}
+
+type ctxKey struct{}
+
+func TestEngine_ExecuteOrdered(t *testing.T) {
+	e := NewEngine()
+	data := NewDataProvider(map[string]any{"items": []any{"", "x", ""}})
+	res, err := e.ExecuteOrdered(context.Background(), data, []contract.FieldRules{
+		{Field: "zip", Rules: "required"},
+		{Field: "items.*", Rules: "required"},
+		{Field: "address", Rules: "required"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"zip", "items.0", "items.2", "address"}
+	got := res.Fields()
+	if len(got) != len(want) {
+		t.Fatalf("unexpected fields: %v", got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("unexpected field order: %v, want %v", got, want)
+		}
+	}
+
+	// map input falls back to sorted field order
+	mapRes := e.Execute(data, map[string]string{"zip": "required", "address": "required"})
+	if fields := mapRes.Fields(); fields[0] != "address" || fields[1] != "zip" {
+		t.Fatalf("expected sorted fields, got %v", fields)
+	}
+}
+
+func TestEngine_StructuredFailures(t *testing.T) {
+	e := NewEngine()
+	data := NewDataProvider(map[string]any{
+		"name":  "ab",
+		"items": []any{map[string]any{"type": "digital"}},
+	})
+	res := e.Execute(data, map[string]string{
+		"name":          "min:3",
+		"items.*.price": "required_if:items.*.type,digital",
+		"zip":           "nonexistent",
+	})
+
+	failures := res.Failures()
+	if len(failures) != 3 {
+		t.Fatalf("expected 3 failures, got %+v", failures)
+	}
+
+	byField := make(map[string]contract.FieldFailure)
+	for _, f := range failures {
+		byField[f.Field] = f
+	}
+	name := byField["name"]
+	if name.Rule != "min" || name.Code != "validation.min.string" || name.Value != "ab" ||
+		len(name.Params) != 1 || name.Params[0] != "3" || name.Message != res.FieldError("name") {
+		t.Errorf("unexpected name failure: %+v", name)
+	}
+	price := byField["items.0.price"]
+	if price.Code != "validation.required_if" || price.Params[0] != "items.0.type" {
+		t.Errorf("unexpected price failure: %+v", price)
+	}
+	if zip := byField["zip"]; zip.Code != contract.CodeUnknownRule || zip.Rule != "nonexistent" {
+		t.Errorf("unexpected zip failure: %+v", zip)
+	}
+}
+
+func TestEngine_NumericRulesMeasureNumericStrings(t *testing.T) {
+	e := NewEngine()
+	data := NewDataProvider(map[string]any{"qty": "2", "count": "10", "name": "10", "code": "ab"})
+	res := e.Execute(data, map[string]string{
+		"qty":   "numeric|min:3",
+		"count": "integer|max:5",
+		"name":  "min:3",
+		"code":  "numeric|min:3",
+	})
+
+	byField := make(map[string]contract.FieldFailure)
+	for _, f := range res.Failures() {
+		byField[f.Field+"."+f.Rule] = f
+	}
+	if qty := byField["qty.min"]; qty.Code != "validation.min.numeric" || qty.Message != "The qty must be at least 3" ||
+		qty.Value != "2" {
+		t.Errorf("unexpected qty failure: %+v", qty)
+	}
+	if count := byField["count.max"]; count.Code != "validation.max.numeric" {
+		t.Errorf("expected 10 to exceed 5 by value, got %+v", count)
+	}
+	// Without a numeric rule, numeric strings are measured by length
+	if name := byField["name.min"]; name.Code != "validation.min.string" {
+		t.Errorf("expected the length of name to be measured, got %+v", name)
+	}
+	// Strings that are not numbers keep their length
+	if code := byField["code.min"]; code.Code != "validation.min.string" {
+		t.Errorf("expected the length of code to be measured, got %+v", code)
+	}
+}
+
+// reasonRule fails with two typed reasons
+type reasonRule struct{}
+
+func (r *reasonRule) Name() string { return "strength" }
+func (r *reasonRule) Validate(_ contract.RuleContext) error {
+	return contract.FailWith(
+		contract.NewReason("password.numbers", "needs a number", nil),
+		contract.NewReason("strength.entropy", "entropy below :bits bits", map[string]string{"bits": "40"}),
+	)
+}
+
+func TestEngine_FailureReasons(t *testing.T) {
+	e := NewEngine()
+	_ = e.RegisterRule("strength", func(_ []string) (contract.Rule, error) { return &reasonRule{}, nil })
+	data := NewDataProvider(map[string]any{"secret": "abc"})
+
+	res := e.Execute(data, map[string]string{"secret": "bail|strength|min:10"})
+	failures := res.Failures()
+	if len(failures) != 2 {
+		t.Fatalf("expected one failure per reason and bail to stop, got %+v", failures)
+	}
+	if f := failures[0]; f.Rule != "strength" || f.Reason != "password.numbers" ||
+		f.Code != "validation.password.numbers" || f.Message != "The secret must contain at least one number" {
+		t.Errorf("unexpected catalog reason failure: %+v", f)
+	}
+	if f := failures[1]; f.Reason != "strength.entropy" || f.Message != "entropy below 40 bits" {
+		t.Errorf("unexpected fallback reason failure: %+v", f)
+	}
+
+	e.SetCustomMessage("strength.entropy", "The :attribute is too predictable (:bits bits)")
+	res = e.Execute(data, map[string]string{"secret": "strength"})
+	if got := res.Errors()["secret"]; len(got) != 2 || got[1] != "The secret is too predictable (40 bits)" {
+		t.Errorf("unexpected messages: %v", got)
+	}
+}
+
+// barrierRule blocks until the given number of rules are running at the same time
+type barrierRule struct {
+	arrived *sync.WaitGroup
+}
+
+func (r *barrierRule) Name() string { return "barrier" }
+func (r *barrierRule) Validate(_ contract.RuleContext) error {
+	r.arrived.Done()
+	done := make(chan struct{})
+	go func() {
+		r.arrived.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-time.After(time.Second):
+		return errors.New("fields were not validated concurrently")
+	}
+}
+
+func TestEngine_ConcurrentMatchesSequential(t *testing.T) {
+	data := NewDataProvider(map[string]any{
+		"name":  "ab",
+		"email": "bad",
+		"items": []any{"", "x", ""},
+	})
+	fieldRules := []contract.FieldRules{
+		{Field: "zip", Rules: "required"},
+		{Field: "name", Rules: "bail|min:3|email"},
+		{Field: "items.*", Rules: "required"},
+		{Field: "email", Rules: "email|min:5"},
+	}
+
+	sequential, _ := NewEngine().ExecuteOrdered(context.Background(), data, fieldRules)
+
+	e := NewEngine()
+	e.SetConcurrency(3)
+	for i := 0; i < 20; i++ {
+		concurrent, err := e.ExecuteOrdered(context.Background(), data, fieldRules)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(concurrent.Failures(), sequential.Failures()) {
+			t.Fatalf("concurrent result differs:\n%+v\n%+v", concurrent.Failures(), sequential.Failures())
+		}
+	}
+}
+
+func TestEngine_ConcurrentFieldsOverlap(t *testing.T) {
+	e := NewEngine()
+	e.SetConcurrency(2)
+	var arrived sync.WaitGroup
+	arrived.Add(2)
+	_ = e.RegisterRule("barrier", func(_ []string) (contract.Rule, error) {
+		return &barrierRule{arrived: &arrived}, nil
+	})
+
+	res := e.Execute(NewDataProvider(map[string]any{"a": "x", "b": "y"}), map[string]string{
+		"a": "barrier",
+		"b": "barrier",
+	})
+	if !res.IsValid() {
+		t.Fatalf("expected both fields to run at once, got %v", res.Errors())
+	}
+}
+
+func TestEngine_ConcurrentCanceled(t *testing.T) {
+	e := NewEngine()
+	e.SetConcurrency(4)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := e.ExecuteContext(ctx, NewDataProvider(map[string]any{}), map[string]string{
+		"a": "required",
+		"b": "required",
+	})
+	if !errors.Is(err, contract.ErrValidationCanceled) {
+		t.Fatalf("expected canceled error, got %v", err)
+	}
+}
@@ -2,10 +2,12 @@
 package facade
 
 import (
+	"context"
 	"sync"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/engine"
+	"github.com/next-trace/scg-validator/message"
 	rules2 "github.com/next-trace/scg-validator/registry/rules"
 	"github.com/next-trace/scg-validator/rules"
 )
@@ -33,18 +35,32 @@ func New(options ...rules2.Option) *ValidatorFacade {
 	eng := &engine.Engine{
 		Registry: registry,
 	}
+	eng.SetMessageResolver(message.NewResolver())
 
 	return &ValidatorFacade{
 		engine: eng,
 	}
 }
 
-// ValidatorRequest represents a validator request similar to Laravel's Validator
+// SetCustomMessage sets a default message for a rule, used by every request of the facade
+func (v *ValidatorFacade) SetCustomMessage(rule, msg string) {
+	v.engine.SetCustomMessage(rule, msg)
+}
+
+// SetCustomAttribute sets a default attribute name for a field, used by every request of the facade
+func (v *ValidatorFacade) SetCustomAttribute(field, name string) {
+	v.engine.SetCustomAttribute(field, name)
+}
+
+// ValidatorRequest represents a validator request similar to Laravel's Validator.
+// Its messages and attributes apply to this request only; they override the facade defaults
+// without changing them.
 type ValidatorRequest struct {
-	data     contract.DataProvider
-	rules    map[string][]string
-	messages map[string]string
-	engine   *engine.Engine
+	data       contract.DataProvider
+	rules      map[string][]string
+	messages   map[string]string
+	attributes map[string]string
+	engine     *engine.Engine
 }
 
 // Make creates a new validator instance (Laravel-style API)
@@ -101,6 +117,13 @@ func (v *ValidatorFacade) ValidateMap(data map[string]interface{}, rules map[str
 
 // Validate executes the validator and returns results
 func (vr *ValidatorRequest) Validate() *contract.ValidationErrors {
+	validationErrors, _ := vr.ValidateContext(context.Background())
+	return validationErrors
+}
+
+// ValidateContext executes the validator with ctx available to rules and verifiers.
+// It returns an error wrapping contract.ErrValidationCanceled when ctx is done before validation completes.
+func (vr *ValidatorRequest) ValidateContext(ctx context.Context) (*contract.ValidationErrors, error) {
 	// Convert map[strings][]strings rules to map[strings]strings
 	rulesMap := make(map[string]string)
 	for field, fieldRules := range vr.rules {
@@ -117,22 +140,19 @@ func (vr *ValidatorRequest) Validate() *contract.ValidationErrors {
 		}
 	}
 
-	// Execute validator using the engine
-	result := vr.engine.Execute(vr.data, rulesMap)
+	// Execute validator using a request-scoped engine
+	result, err := vr.requestEngine().ExecuteContext(ctx, vr.data, rulesMap)
 
 	// Convert result to ValidationErrors
 	validationErrors := contract.NewValidationErrors()
 	if !result.IsValid() {
-		// Add errors from the result
-		errors := result.Errors()
-		for field, fieldErrors := range errors {
-			for _, err := range fieldErrors {
-				validationErrors.AddError(field, err)
-			}
+		// Add failures from the result, keeping their order and structured details
+		for _, failure := range result.Failures() {
+			validationErrors.AddFailure(failure)
 		}
 	}
 
-	return validationErrors
+	return validationErrors, err
 }
 
 // Fails returns true if validator failed (Laravel-style API)
@@ -178,36 +198,66 @@ func (vr *ValidatorRequest) WithRules(additionalRules map[string][]string) *Vali
 	}
 
 	return &ValidatorRequest{
-		data:     vr.data,
-		rules:    newRules,
-		messages: vr.messages,
-		engine:   vr.engine,
+		data:       vr.data,
+		rules:      newRules,
+		messages:   vr.messages,
+		attributes: vr.attributes,
+		engine:     vr.engine,
 	}
 }
 
-// WithMessages allows adding custom messages (Laravel-style API)
+// WithMessages allows adding custom messages (Laravel-style API).
+// Keys are rule names, "<rule>.<field>" or Laravel's "<field>.<rule>".
 func (vr *ValidatorRequest) WithMessages(additionalMessages map[string]string) *ValidatorRequest {
-	// Merge the messages
-	newMessages := make(map[string]string)
+	return &ValidatorRequest{
+		data:       vr.data,
+		rules:      vr.rules,
+		messages:   mergeStrings(vr.messages, additionalMessages),
+		attributes: vr.attributes,
+		engine:     vr.engine,
+	}
+}
 
-	// Copy existing messages
-	if vr.messages != nil {
-		for key, msg := range vr.messages {
-			newMessages[key] = msg
-		}
+// WithAttributes allows adding custom attribute names used in messages (Laravel-style API)
+func (vr *ValidatorRequest) WithAttributes(additionalAttributes map[string]string) *ValidatorRequest {
+	return &ValidatorRequest{
+		data:       vr.data,
+		rules:      vr.rules,
+		messages:   vr.messages,
+		attributes: mergeStrings(vr.attributes, additionalAttributes),
+		engine:     vr.engine,
+	}
+}
+
+// mergeStrings returns a new map holding the entries of base overridden by those of extra
+func mergeStrings(base, extra map[string]string) map[string]string {
+	merged := make(map[string]string, len(base)+len(extra))
+	for key, value := range base {
+		merged[key] = value
+	}
+	for key, value := range extra {
+		merged[key] = value
 	}
+	return merged
+}
 
-	// Add new messages
-	for key, msg := range additionalMessages {
-		newMessages[key] = msg
+// requestEngine returns an engine whose resolver is seeded from the facade defaults and
+// carries the request's messages and attributes, leaving the shared engine untouched
+func (vr *ValidatorRequest) requestEngine() contract.ValidationEngine {
+	var resolver contract.MessageResolver
+	if vr.engine.MessageResolver != nil {
+		resolver = vr.engine.MessageResolver.Clone()
+	} else {
+		resolver = message.NewRequestScopedResolver()
 	}
 
-	return &ValidatorRequest{
-		data:     vr.data,
-		rules:    vr.rules,
-		messages: newMessages,
-		engine:   vr.engine,
+	for key, msg := range vr.messages {
+		resolver.SetCustomMessage(key, msg)
+	}
+	for field, name := range vr.attributes {
+		resolver.SetCustomAttribute(field, name)
 	}
+	return vr.engine.CloneWithResolver(resolver)
 }
 
 // Extend allows registering custom rules (Laravel-style API)
@@ -222,17 +272,17 @@ func (v *ValidatorFacade) Extend(ruleName string, ruleCreator contract.RuleCreat
 	_ = v.engine.Registry.Register(ruleName, ruleCreator)
 }
 
-// ExtendImplicit allows registering custom implicit rules (Laravel-style API)
-// Usage: facade.ExtendImplicit("sometimes", func(parameters []strings) (contract.Rule, error) { ... })
+// ExtendImplicit allows registering custom implicit rules (Laravel-style API).
+// Implicit rules run even when the field is missing or empty.
+// Usage: facade.ExtendImplicit("required_for_tenant", func(parameters []strings) (contract.Rule, error) { ... })
 func ExtendImplicit(ruleName string, ruleCreator contract.RuleCreator) {
-	// For now, treat implicit rules the same as regular rules
-	// In the future, we could add special handling for implicit rules
-	Extend(ruleName, ruleCreator)
+	getGlobalValidator().ExtendImplicit(ruleName, ruleCreator)
 }
 
 // ExtendImplicit allows registering custom implicit rules
 func (v *ValidatorFacade) ExtendImplicit(ruleName string, ruleCreator contract.RuleCreator) {
-	v.Extend(ruleName, ruleCreator)
+	// Register the rule as implicit in the existing registry
+	_ = v.engine.Registry.RegisterImplicit(ruleName, ruleCreator)
 }
 
 // Rules returns the list of available rules
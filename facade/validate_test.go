@@ -1,6 +1,7 @@
 package facade
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/next-trace/scg-validator/contract"
@@ -74,3 +75,59 @@ func TestFacadeRulesAndHasRule(t *testing.T) {
 		t.Fatal("unexpected non-existent rule reported as existing")
 	}
 }
+
+type tenantRule struct{}
+
+func (r tenantRule) Name() string { return "required_for_tenant" }
+func (r tenantRule) Validate(ctx contract.RuleContext) error {
+	if ctx.Value() == nil {
+		return errors.New("tenant value required")
+	}
+	return nil
+}
+
+func TestExtendImplicitRunsOnMissingFields(t *testing.T) {
+	v := New()
+	creator := func(_ []string) (contract.Rule, error) { return tenantRule{}, nil }
+	v.ExtendImplicit("required_for_tenant", creator)
+	v.Extend("ordinary_tenant", creator)
+
+	data := contract.NewSimpleDataProvider(map[string]any{})
+	errs := v.Validate(data, map[string][]string{
+		"tenant_id": {"required_for_tenant"},
+		"other_id":  {"ordinary_tenant"},
+	})
+	if !errs.HasFieldError("tenant_id") {
+		t.Fatal("expected implicit rule to run for missing field")
+	}
+	if errs.HasFieldError("other_id") {
+		t.Fatal("expected ordinary rule to be skipped for missing field")
+	}
+}
+
+func TestRequestMessagesAndAttributes(t *testing.T) {
+	v := New()
+	v.SetCustomAttribute("email", "e-mail")
+	data := contract.NewSimpleDataProvider(map[string]any{"name": "", "email": "nope"})
+	rules := map[string][]string{"name": {"required"}, "email": {"email"}}
+
+	errs := v.Make(data, rules).
+		WithMessages(map[string]string{"name.required": "Tell us your name"}).
+		WithAttributes(map[string]string{"email": "work email"}).
+		Validate()
+	if got := errs.FieldError("name"); got != "Tell us your name" {
+		t.Errorf("expected <field>.<rule> message, got %q", got)
+	}
+	if got := errs.FieldError("email"); got != "The work email must be a valid email address" {
+		t.Errorf("expected request attribute, got %q", got)
+	}
+
+	// Request overrides must not leak into the facade, whose defaults still apply
+	errs = v.Make(data, rules).Validate()
+	if got := errs.FieldError("name"); got == "Tell us your name" {
+		t.Errorf("request message leaked into the facade: %q", got)
+	}
+	if got := errs.FieldError("email"); got != "The e-mail must be a valid email address" {
+		t.Errorf("expected facade attribute, got %q", got)
+	}
+}
@@ -0,0 +1,343 @@
+package message
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/next-trace/scg-validator/contract"
+	"golang.org/x/text/language"
+)
+
+// Sections of a catalog file that do not hold rule messages
+const (
+	// AttributesKey is the catalog section holding localized attribute names
+	AttributesKey = "attributes"
+	// CustomKey is the Laravel-style section holding per-field messages as field -> rule -> message
+	CustomKey = "custom"
+)
+
+// englishCatalogPath is the embedded English catalog shipped with the library
+const englishCatalogPath = "lang/en/validation.json"
+
+//go:embed lang/en/validation.json
+var builtinCatalogs embed.FS
+
+// Catalog holds the messages and attribute names of a single locale. Messages are keyed by rule
+// name or by "<rule>.<field>" for field-specific overrides. Variants holds per-type messages
+// keyed by rule and then by value type (numeric, string, array or file).
+type Catalog struct {
+	Tag        language.Tag
+	Messages   map[string]string
+	Variants   map[string]map[string]string
+	Attributes map[string]string
+}
+
+// NewCatalog creates a catalog for tag
+func NewCatalog(tag language.Tag, messages, attributes map[string]string) *Catalog {
+	catalog := &Catalog{
+		Tag:        tag,
+		Messages:   make(map[string]string, len(messages)),
+		Variants:   make(map[string]map[string]string),
+		Attributes: make(map[string]string, len(attributes)),
+	}
+	for k, v := range messages {
+		catalog.Messages[k] = v
+	}
+	for k, v := range attributes {
+		catalog.Attributes[k] = v
+	}
+	return catalog
+}
+
+// ParseCatalog reads a JSON catalog laid out like a Laravel validation language file:
+//
+//	{
+//	  "required": "The :attribute field is required",
+//	  "required.email": "We need your email address",
+//	  "min": {"numeric": "The :attribute must be at least :param0", "string": "..."},
+//	  "custom": {"email": {"email": "That does not look like an email address"}},
+//	  "attributes": {"email": "email address"}
+//	}
+//
+// Keys map to rule messages or "<rule>.<field>" overrides, objects under a rule key hold
+// per-type variants, "custom" holds per-field overrides and "attributes" localized attribute names.
+func ParseCatalog(tag language.Tag, data []byte) (*Catalog, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parse %s catalog: %w", tag, err)
+	}
+
+	catalog := NewCatalog(tag, nil, nil)
+	for key, value := range raw {
+		switch key {
+		case AttributesKey:
+			if err := json.Unmarshal(value, &catalog.Attributes); err != nil {
+				return nil, fmt.Errorf("parse %s catalog attributes: %w", tag, err)
+			}
+		case CustomKey:
+			var custom map[string]map[string]string
+			if err := json.Unmarshal(value, &custom); err != nil {
+				return nil, fmt.Errorf("parse %s catalog custom messages: %w", tag, err)
+			}
+			for field, rules := range custom {
+				for rule, msg := range rules {
+					catalog.Messages[rule+"."+field] = msg
+				}
+			}
+		default:
+			if err := catalog.parseMessage(key, value); err != nil {
+				return nil, fmt.Errorf("parse %s catalog message %q: %w", tag, key, err)
+			}
+		}
+	}
+	return catalog, nil
+}
+
+// parseMessage stores a rule message, which is either a string or an object of per-type variants
+func (c *Catalog) parseMessage(key string, value json.RawMessage) error {
+	var msg string
+	if err := json.Unmarshal(value, &msg); err == nil {
+		c.Messages[key] = msg
+		return nil
+	}
+
+	var variants map[string]string
+	if err := json.Unmarshal(value, &variants); err != nil {
+		return fmt.Errorf("expected a string or an object of strings: %w", err)
+	}
+	c.Variants[key] = variants
+	return nil
+}
+
+// lookup returns the message for key, preferring the variant for kind. A rule that only has
+// variants falls back to its numeric variant, the generic form of a size message.
+func (c *Catalog) lookup(key, kind string) (string, bool) {
+	variants := c.Variants[key]
+	if msg, ok := variants[kind]; ok && kind != "" {
+		return msg, true
+	}
+	if msg, ok := c.Messages[key]; ok {
+		return msg, true
+	}
+	msg, ok := variants[string(contract.KindNumeric)]
+	return msg, ok
+}
+
+// merge copies the entries of other into c, overriding existing ones
+func (c *Catalog) merge(other *Catalog) {
+	for k, v := range other.Messages {
+		c.Messages[k] = v
+	}
+	for rule, variants := range other.Variants {
+		if c.Variants[rule] == nil {
+			c.Variants[rule] = make(map[string]string, len(variants))
+		}
+		for kind, msg := range variants {
+			c.Variants[rule][kind] = msg
+		}
+	}
+	for k, v := range other.Attributes {
+		c.Attributes[k] = v
+	}
+}
+
+// Catalogs is a set of catalogs selected by language. Lookups use the x/text matcher, so a
+// request for de-AT uses the de catalog, and fall back to the parent locales and finally to
+// the fallback catalog. Catalogs is safe for concurrent use.
+type Catalogs struct {
+	mu       sync.RWMutex
+	fallback language.Tag
+	catalogs map[language.Tag]*Catalog
+	tags     []language.Tag
+	matcher  language.Matcher
+}
+
+// NewCatalogs creates a catalog set whose fallback catalog is used when no better match exists
+func NewCatalogs(fallback *Catalog) *Catalogs {
+	c := &Catalogs{
+		fallback: fallback.Tag,
+		catalogs: make(map[language.Tag]*Catalog),
+	}
+	c.Register(fallback)
+	return c
+}
+
+// Register adds a catalog. Entries of a catalog already registered for the same tag are merged,
+// with the new catalog taking precedence.
+func (c *Catalogs) Register(catalog *Catalog) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if existing, ok := c.catalogs[catalog.Tag]; ok {
+		existing.merge(catalog)
+		return
+	}
+
+	registered := NewCatalog(catalog.Tag, nil, nil)
+	registered.merge(catalog)
+	c.catalogs[catalog.Tag] = registered
+	c.tags = append(c.tags, catalog.Tag)
+	// The fallback comes first so that the matcher returns it when nothing matches
+	ordered := []language.Tag{c.fallback}
+	for _, tag := range c.tags {
+		if tag != c.fallback {
+			ordered = append(ordered, tag)
+		}
+	}
+	c.tags = ordered
+	c.matcher = language.NewMatcher(c.tags)
+}
+
+// Tags returns the registered locales, starting with the fallback
+func (c *Catalogs) Tags() []language.Tag {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	tags := make([]language.Tag, len(c.tags))
+	copy(tags, c.tags)
+	return tags
+}
+
+// catalogEntry is a message found along the fallback chain, with its key and catalog locale
+type catalogEntry struct {
+	key     string
+	message string
+	locale  language.Tag
+}
+
+// Message returns the message for key in the best catalog for tag, following the fallback chain
+func (c *Catalogs) Message(tag language.Tag, key string) (string, bool) {
+	return c.MessageFor(tag, key, "")
+}
+
+// MessageFor returns the message for key, preferring the variant for the value type kind in
+// each catalog of the fallback chain before moving on to the next one
+func (c *Catalogs) MessageFor(tag language.Tag, key, kind string) (string, bool) {
+	entry, ok := c.messageEntry(tag, key, kind)
+	return entry.message, ok
+}
+
+// messageEntry is MessageFor reporting where the message was found
+func (c *Catalogs) messageEntry(tag language.Tag, key, kind string) (catalogEntry, bool) {
+	return c.find(tag, func(catalog *Catalog) (string, string, bool) {
+		msg, ok := catalog.lookup(key, kind)
+		return key, msg, ok
+	})
+}
+
+// FieldMessage returns the field-specific message for key, stored as "<key>.<field>",
+// "<field>.<key>" or under a wildcard pattern such as "required.items.*.price", following the
+// fallback chain. Within a catalog an exact field wins over patterns, and the most specific
+// pattern wins over others.
+func (c *Catalogs) FieldMessage(tag language.Tag, key, field string) (string, bool) {
+	entry, ok := c.fieldEntry(tag, key, field, RuleFieldOrder)
+	return entry.message, ok
+}
+
+// fieldEntry is FieldMessage trying keys in order first and reporting where the message was found
+func (c *Catalogs) fieldEntry(tag language.Tag, key, field string, order KeyOrder) (catalogEntry, bool) {
+	return c.find(tag, func(catalog *Catalog) (string, string, bool) {
+		return fieldEntry(catalog.Messages, key, field, order)
+	})
+}
+
+// Reason returns the message of a failure reason key such as "password.symbols", stored either
+// flat or nested under its rule, following the fallback chain
+func (c *Catalogs) Reason(tag language.Tag, key string) (string, bool) {
+	entry, ok := c.reasonEntry(tag, key)
+	return entry.message, ok
+}
+
+// reasonEntry is Reason reporting where the message was found
+func (c *Catalogs) reasonEntry(tag language.Tag, key string) (catalogEntry, bool) {
+	rule, reason, nested := strings.Cut(key, ".")
+	return c.find(tag, func(catalog *Catalog) (string, string, bool) {
+		if msg, ok := catalog.Messages[key]; ok {
+			return key, msg, true
+		}
+		msg, ok := catalog.Variants[rule][reason]
+		return key, msg, ok && nested
+	})
+}
+
+// Variant returns the message for rule and value type kind, following the fallback chain
+func (c *Catalogs) Variant(tag language.Tag, rule, kind string) (string, bool) {
+	entry, ok := c.find(tag, func(catalog *Catalog) (string, string, bool) {
+		msg, ok := catalog.Variants[rule][kind]
+		return rule, msg, ok
+	})
+	return entry.message, ok
+}
+
+// Attribute returns the localized attribute name for field, which may be stored under a
+// wildcard pattern such as "items.*.price", following the fallback chain
+func (c *Catalogs) Attribute(tag language.Tag, field string) (string, bool) {
+	entry, ok := c.find(tag, func(catalog *Catalog) (string, string, bool) {
+		if attribute, ok := catalog.Attributes[field]; ok {
+			return field, attribute, true
+		}
+		return matchWildcard(catalog.Attributes, "", "", field)
+	})
+	return entry.message, ok
+}
+
+// find returns the first entry reported by lookup along the fallback chain for tag
+func (c *Catalogs) find(tag language.Tag, lookup func(*Catalog) (string, string, bool)) (catalogEntry, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, catalog := range c.chain(tag) {
+		if key, msg, ok := lookup(catalog); ok {
+			return catalogEntry{key: key, message: msg, locale: catalog.Tag}, true
+		}
+	}
+	return catalogEntry{}, false
+}
+
+// chain lists the catalogs to consult for tag: the best match, its registered parents and the
+// fallback. Callers must hold the read lock.
+func (c *Catalogs) chain(tag language.Tag) []*Catalog {
+	fallback := c.catalogs[c.fallback]
+	if tag == language.Und || tag == c.fallback {
+		return []*Catalog{fallback}
+	}
+
+	var chain []*Catalog
+	if _, index, confidence := c.matcher.Match(tag); confidence != language.No {
+		for t := c.tags[index]; ; t = t.Parent() {
+			if catalog, ok := c.catalogs[t]; ok && t != c.fallback {
+				chain = append(chain, catalog)
+			}
+			if t.IsRoot() {
+				break
+			}
+		}
+	}
+	return append(chain, fallback)
+}
+
+var (
+	englishCatalog  = mustLoadBuiltinCatalog(language.English, englishCatalogPath)
+	defaultCatalogs = NewCatalogs(englishCatalog)
+)
+
+// DefaultCatalogs returns the catalog set used by resolvers unless another one is configured.
+// It contains the built-in English catalog.
+func DefaultCatalogs() *Catalogs {
+	return defaultCatalogs
+}
+
+// RegisterCatalog adds a catalog to the default catalog set
+func RegisterCatalog(catalog *Catalog) {
+	defaultCatalogs.Register(catalog)
+}
+
+// mustLoadBuiltinCatalog parses a catalog embedded in the library
+func mustLoadBuiltinCatalog(tag language.Tag, path string) *Catalog {
+	catalog, err := LoadCatalog(builtinCatalogs, path, tag)
+	if err != nil {
+		panic(fmt.Sprintf("failed to load built-in catalog: %v", err))
+	}
+	return catalog
+}
@@ -0,0 +1,162 @@
+package message
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func testCatalogs(t *testing.T) *Catalogs {
+	t.Helper()
+	catalogs := NewCatalogs(NewCatalog(language.English, map[string]string{
+		"required": "The :attribute field is required",
+		"email":    "The :attribute must be a valid email address",
+	}, nil))
+	catalogs.Register(NewCatalog(language.German, map[string]string{
+		"required": ":attribute ist erforderlich",
+	}, map[string]string{"email": "E-Mail-Adresse"}))
+	return catalogs
+}
+
+func TestParseCatalog(t *testing.T) {
+	catalog, err := ParseCatalog(language.French, []byte(`{
+		"required": "Le champ :attribute est obligatoire",
+		"attributes": {"email": "adresse e-mail"}
+	}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if catalog.Messages["required"] != "Le champ :attribute est obligatoire" {
+		t.Errorf("unexpected message: %q", catalog.Messages["required"])
+	}
+	if catalog.Attributes["email"] != "adresse e-mail" {
+		t.Errorf("unexpected attribute: %q", catalog.Attributes["email"])
+	}
+	if _, ok := catalog.Messages[AttributesKey]; ok {
+		t.Error("attributes section must not be treated as a message")
+	}
+
+	if _, err := ParseCatalog(language.French, []byte(`{"required": 1}`)); err == nil {
+		t.Error("expected error for non-string message")
+	}
+	if _, err := ParseCatalog(language.French, []byte(`{`)); err == nil {
+		t.Error("expected error for invalid JSON")
+	}
+}
+
+func TestCatalogs_MatcherFallback(t *testing.T) {
+	catalogs := testCatalogs(t)
+
+	tests := []struct {
+		name string
+		tag  language.Tag
+		key  string
+		want string
+	}{
+		{"exact match", language.German, "required", ":attribute ist erforderlich"},
+		{"regional variant uses base language", language.MustParse("de-AT"), "required", ":attribute ist erforderlich"},
+		{"missing key falls back to english", language.MustParse("de-AT"), "email",
+			"The :attribute must be a valid email address"},
+		{"unknown language uses english", language.Japanese, "required", "The :attribute field is required"},
+		{"undetermined uses english", language.Und, "required", "The :attribute field is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := catalogs.Message(tt.tag, tt.key)
+			if !ok || got != tt.want {
+				t.Errorf("Message(%s, %s) = %q, %v; want %q", tt.tag, tt.key, got, ok, tt.want)
+			}
+		})
+	}
+
+	if _, ok := catalogs.Message(language.German, "no_such_rule"); ok {
+		t.Error("expected no message for unknown key")
+	}
+}
+
+func TestCatalogs_RegionalCatalogFallsBackToParent(t *testing.T) {
+	catalogs := testCatalogs(t)
+	catalogs.Register(NewCatalog(language.MustParse("de-CH"), map[string]string{
+		"email": ":attribute muss eine gültige E-Mail-Adresse sein",
+	}, nil))
+
+	swiss := language.MustParse("de-CH")
+	if got, _ := catalogs.Message(swiss, "email"); got != ":attribute muss eine gültige E-Mail-Adresse sein" {
+		t.Errorf("expected regional message, got %q", got)
+	}
+	if got, _ := catalogs.Message(swiss, "required"); got != ":attribute ist erforderlich" {
+		t.Errorf("expected parent message, got %q", got)
+	}
+	if got, _ := catalogs.Attribute(swiss, "email"); got != "E-Mail-Adresse" {
+		t.Errorf("expected parent attribute, got %q", got)
+	}
+}
+
+func TestCatalogs_RegisterMerges(t *testing.T) {
+	catalogs := testCatalogs(t)
+	catalogs.Register(NewCatalog(language.German, map[string]string{"email": "Ungültige E-Mail"}, nil))
+
+	if got, _ := catalogs.Message(language.German, "required"); got != ":attribute ist erforderlich" {
+		t.Errorf("existing entry lost after merge: %q", got)
+	}
+	if got, _ := catalogs.Message(language.German, "email"); got != "Ungültige E-Mail" {
+		t.Errorf("merged entry missing: %q", got)
+	}
+	if tags := catalogs.Tags(); len(tags) != 2 || tags[0] != language.English {
+		t.Errorf("unexpected tags: %v", tags)
+	}
+}
+
+func TestBuiltinEnglishCatalog(t *testing.T) {
+	msg, ok := DefaultMessage("required")
+	if !ok || msg != "The :attribute field is required" {
+		t.Fatalf("unexpected built-in message: %q", msg)
+	}
+	if got, _ := DefaultCatalogs().Message(language.English, "between"); got == "" {
+		t.Error("expected built-in catalog to be registered by default")
+	}
+}
+
+func TestResolver_Locale(t *testing.T) {
+	r := NewResolverWithCatalogs(testCatalogs(t))
+	r.SetLocale(language.MustParse("de-AT"))
+
+	if got := r.Resolve("required", "email", nil, nil); got != "E-Mail-Adresse ist erforderlich" {
+		t.Errorf("unexpected localized message: %q", got)
+	}
+
+	r.SetCustomAttribute("email", "Mail")
+	if got := r.Resolve("required", "email", nil, nil); got != "Mail ist erforderlich" {
+		t.Errorf("custom attribute must win over localized attribute: %q", got)
+	}
+
+	clone := r.Clone().(*Resolver)
+	if clone.Locale() != r.Locale() {
+		t.Errorf("clone lost locale: %s", clone.Locale())
+	}
+	clone.SetLocale(language.English)
+	if got := clone.Resolve("required", "name", nil, nil); got != "The name field is required" {
+		t.Errorf("unexpected english message: %q", got)
+	}
+	if got := r.Resolve("required", "name", nil, nil); got != "name ist erforderlich" {
+		t.Errorf("original resolver changed by clone: %q", got)
+	}
+}
+
+func TestCatalogs_VariantsPreferMatchedLocale(t *testing.T) {
+	catalogs := NewCatalogs(NewCatalog(language.English, nil, nil))
+	english := NewCatalog(language.English, nil, nil)
+	english.Variants["min"] = map[string]string{"numeric": "at least :param0", "string": "at least :param0 characters"}
+	catalogs.Register(english)
+	catalogs.Register(NewCatalog(language.German, map[string]string{"min": "mindestens :param0"}, nil))
+
+	if got, _ := catalogs.MessageFor(language.German, "min", "string"); got != "mindestens :param0" {
+		t.Errorf("plain message of the matched locale must win over fallback variants: %q", got)
+	}
+	if got, _ := catalogs.MessageFor(language.English, "min", "string"); got != "at least :param0 characters" {
+		t.Errorf("unexpected variant: %q", got)
+	}
+	if got, _ := catalogs.MessageFor(language.English, "min", "file"); got != "at least :param0" {
+		t.Errorf("missing variant must fall back to the numeric form: %q", got)
+	}
+}
@@ -1,3 +1,3 @@
-// Package message provides default messages and a resolver for rule messages
-// and attribute names.
+// Package message provides default messages, per-locale message catalogs and a resolver for
+// rule messages and attribute names.
 package message
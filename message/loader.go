@@ -0,0 +1,76 @@
+package message
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// CatalogFileName is the name of the catalog file inside each locale directory
+const CatalogFileName = "validation.json"
+
+// LoadCatalog reads and parses a single catalog file from fsys
+func LoadCatalog(fsys fs.FS, name string, tag language.Tag) (*Catalog, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, fmt.Errorf("read catalog %s: %w", name, err)
+	}
+	catalog, err := ParseCatalog(tag, data)
+	if err != nil {
+		return nil, fmt.Errorf("load catalog %s: %w", name, err)
+	}
+	return catalog, nil
+}
+
+// LoadCatalogs reads every <root>/<locale>/validation.json file from fsys, e.g. an embed.FS.
+// Locale directories are named by BCP 47 tag; Laravel-style names such as pt_BR are accepted.
+// Directories without a catalog file are skipped.
+func LoadCatalogs(fsys fs.FS, root string) ([]*Catalog, error) {
+	entries, err := fs.ReadDir(fsys, root)
+	if err != nil {
+		return nil, fmt.Errorf("read catalog directory %s: %w", root, err)
+	}
+
+	var catalogs []*Catalog
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		tag, err := language.Parse(strings.ReplaceAll(entry.Name(), "_", "-"))
+		if err != nil {
+			return nil, fmt.Errorf("catalog directory %s: invalid locale: %w", entry.Name(), err)
+		}
+
+		name := path.Join(root, entry.Name(), CatalogFileName)
+		catalog, err := LoadCatalog(fsys, name, tag)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		catalogs = append(catalogs, catalog)
+	}
+	return catalogs, nil
+}
+
+// LoadFS registers every catalog found by LoadCatalogs. Nothing is registered when a file fails to load.
+func (c *Catalogs) LoadFS(fsys fs.FS, root string) error {
+	catalogs, err := LoadCatalogs(fsys, root)
+	if err != nil {
+		return err
+	}
+	for _, catalog := range catalogs {
+		c.Register(catalog)
+	}
+	return nil
+}
+
+// RegisterCatalogsFS adds every catalog found by LoadCatalogs to the default catalog set
+func RegisterCatalogsFS(fsys fs.FS, root string) error {
+	return defaultCatalogs.LoadFS(fsys, root)
+}
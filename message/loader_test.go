@@ -0,0 +1,112 @@
+package message
+
+import (
+	"errors"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"golang.org/x/text/language"
+)
+
+const germanCatalog = `{
+	"required": ":attribute ist erforderlich",
+	"required.email": "Bitte gib deine E-Mail-Adresse an",
+	"min": {"numeric": ":attribute muss mindestens :param0 sein", "string": ":attribute braucht :param0 Zeichen"},
+	"custom": {"password": {"confirmed": "Die Passwörter stimmen nicht überein"}},
+	"attributes": {"name": "Name", "email": "E-Mail-Adresse"}
+}`
+
+func TestLoadCatalogs(t *testing.T) {
+	fsys := fstest.MapFS{
+		"lang/de/validation.json":    {Data: []byte(germanCatalog)},
+		"lang/pt_BR/validation.json": {Data: []byte(`{"required": "O campo :attribute é obrigatório"}`)},
+		"lang/fr/README.md":          {Data: []byte("no catalog here")},
+		"lang/notes.txt":             {Data: []byte("ignored")},
+	}
+
+	catalogs, err := LoadCatalogs(fsys, "lang")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(catalogs) != 2 {
+		t.Fatalf("expected 2 catalogs, got %d", len(catalogs))
+	}
+
+	byTag := make(map[language.Tag]*Catalog)
+	for _, catalog := range catalogs {
+		byTag[catalog.Tag] = catalog
+	}
+	if _, ok := byTag[language.MustParse("pt-BR")]; !ok {
+		t.Errorf("expected pt_BR directory to be loaded as pt-BR, got %v", byTag)
+	}
+
+	de := byTag[language.German]
+	if de == nil {
+		t.Fatal("expected german catalog")
+	}
+	if de.Messages["required.email"] != "Bitte gib deine E-Mail-Adresse an" {
+		t.Errorf("unexpected field override: %q", de.Messages["required.email"])
+	}
+	if de.Messages["confirmed.password"] != "Die Passwörter stimmen nicht überein" {
+		t.Errorf("custom section not mapped to <rule>.<field>: %v", de.Messages)
+	}
+	if de.Variants["min"]["string"] != ":attribute braucht :param0 Zeichen" {
+		t.Errorf("unexpected variants: %v", de.Variants["min"])
+	}
+	if _, ok := de.Messages["min"]; ok {
+		t.Error("variant object must not be stored as a plain message")
+	}
+	if de.Attributes["email"] != "E-Mail-Adresse" {
+		t.Errorf("unexpected attributes: %v", de.Attributes)
+	}
+}
+
+func TestLoadCatalogs_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		fsys fstest.MapFS
+	}{
+		{"invalid json", fstest.MapFS{"lang/de/validation.json": {Data: []byte(`{`)}}},
+		{"invalid message type", fstest.MapFS{"lang/de/validation.json": {Data: []byte(`{"min": [1]}`)}}},
+		{"invalid locale", fstest.MapFS{"lang/not a locale/validation.json": {Data: []byte(`{}`)}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := LoadCatalogs(tt.fsys, "lang"); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+
+	if _, err := LoadCatalogs(fstest.MapFS{}, "lang"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("expected fs.ErrNotExist for missing root, got %v", err)
+	}
+}
+
+func TestCatalogs_LoadFS(t *testing.T) {
+	catalogs := NewCatalogs(NewCatalog(language.English, map[string]string{
+		"required": "The :attribute field is required",
+	}, nil))
+	fsys := fstest.MapFS{"lang/de/validation.json": {Data: []byte(germanCatalog)}}
+	if err := catalogs.LoadFS(fsys, "lang"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, _ := catalogs.Variant(language.MustParse("de-DE"), "min", "numeric")
+	if got != ":attribute muss mindestens :param0 sein" {
+		t.Errorf("unexpected variant: %q", got)
+	}
+	if _, ok := catalogs.Variant(language.German, "min", "file"); ok {
+		t.Error("expected no file variant")
+	}
+
+	r := NewResolverWithCatalogs(catalogs)
+	r.SetLocale(language.German)
+	if got := r.Resolve("required", "email", nil, nil); got != "Bitte gib deine E-Mail-Adresse an" {
+		t.Errorf("expected field override from catalog, got %q", got)
+	}
+	if got := r.Resolve("required", "name", nil, nil); got != "Name ist erforderlich" {
+		t.Errorf("unexpected message: %q", got)
+	}
+}
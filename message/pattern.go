@@ -0,0 +1,95 @@
+package message
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/next-trace/scg-validator/utils"
+)
+
+// fieldEntry finds the field-specific entry of key, which is a rule name or a reason key: an
+// exact "<key>.<field>" or "<field>.<key>" entry, then the most specific wildcard pattern such
+// as "<key>.items.*.price". Keys in the canonical order are tried first. It returns the matched
+// key and its entry.
+func fieldEntry(entries map[string]string, key, field string, order KeyOrder) (string, string, bool) {
+	orders := [2]KeyOrder{order, order.other()}
+	for _, o := range orders {
+		if entry, ok := entries[o.Key(key, field)]; ok {
+			return o.Key(key, field), entry, true
+		}
+	}
+	for _, o := range orders {
+		prefix, suffix := o.affixes(key)
+		if matched, entry, ok := matchWildcard(entries, prefix, suffix, field); ok {
+			return matched, entry, true
+		}
+	}
+	return "", "", false
+}
+
+// matchWildcard returns the key and entry made of prefix, a wildcard pattern matching field and
+// suffix, e.g. "required.items.*.price" for prefix "required." and field "items.3.price".
+// When several patterns match, the most specific one wins: the one with the most literal
+// segments, then the one whose literal segments come first.
+func matchWildcard(entries map[string]string, prefix, suffix, field string) (string, string, bool) {
+	var best []string
+	var bestKey string
+	for key := range entries {
+		if len(key) < len(prefix)+len(suffix) || !strings.HasPrefix(key, prefix) ||
+			!strings.HasSuffix(key, suffix) || !strings.Contains(key, utils.PathWildcard) {
+			continue
+		}
+		pattern := key[len(prefix) : len(key)-len(suffix)]
+		if !utils.HasWildcard(pattern) || !utils.MatchPath(pattern, field) {
+			continue
+		}
+		segments := strings.Split(pattern, utils.PathSeparator)
+		if best == nil || moreSpecific(segments, best) {
+			best, bestKey = segments, key
+		}
+	}
+	if best == nil {
+		return "", "", false
+	}
+	return bestKey, entries[bestKey], true
+}
+
+// moreSpecific reports whether pattern a is more specific than pattern b. Both patterns match
+// the same path, so they have the same number of segments.
+func moreSpecific(a, b []string) bool {
+	if la, lb := literalSegments(a), literalSegments(b); la != lb {
+		return la > lb
+	}
+	for i := range a {
+		if (a[i] == utils.PathWildcard) != (b[i] == utils.PathWildcard) {
+			return b[i] == utils.PathWildcard
+		}
+	}
+	return false
+}
+
+// literalSegments counts the segments of a pattern that are not wildcards
+func literalSegments(segments []string) int {
+	count := 0
+	for _, segment := range segments {
+		if segment != utils.PathWildcard {
+			count++
+		}
+	}
+	return count
+}
+
+// indexPlaceholders returns the :index and :position values of an array element field: the
+// first numeric segment of its path and that index plus one, so "items.3.price" gives 3 and 4.
+// Fields outside arrays have neither.
+func indexPlaceholders(field string) map[string]string {
+	for _, segment := range strings.Split(field, utils.PathSeparator) {
+		if index, err := strconv.Atoi(segment); err == nil && index >= 0 {
+			return map[string]string{
+				"index":    segment,
+				"position": strconv.Itoa(index + 1),
+			}
+		}
+	}
+	return nil
+}
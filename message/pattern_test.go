@@ -0,0 +1,80 @@
+package message
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestResolver_WildcardMessagesAndAttributes(t *testing.T) {
+	r := NewResolver()
+	r.SetCustomMessage("required.items.*.price", "The :attribute is required")
+	r.SetCustomAttribute("items.*.price", "price of item #:position")
+
+	if got := r.Resolve("required", "items.3.price", nil, nil); got != "The price of item #4 is required" {
+		t.Errorf("unexpected wildcard message: %q", got)
+	}
+	// Fields outside the pattern keep the catalog message
+	if got := r.Resolve("required", "items.3.name", nil, nil); got != "The items.3.name field is required" {
+		t.Errorf("unexpected message for unmatched field: %q", got)
+	}
+}
+
+func TestResolver_WildcardMostSpecificWins(t *testing.T) {
+	r := NewResolver()
+	r.SetCustomMessage("required.*.*.price", "any")
+	r.SetCustomMessage("required.items.*.price", "items")
+	r.SetCustomMessage("required.items.0.*", "first item")
+	r.SetCustomMessage("required.items.0.price", "first price")
+
+	cases := map[string]string{
+		"items.0.price":  "first price",
+		"items.0.name":   "first item",
+		"items.2.price":  "items",
+		"orders.2.price": "any",
+	}
+	for field, want := range cases {
+		if got := r.Resolve("required", field, nil, nil); got != want {
+			t.Errorf("Resolve(required, %q) = %q, want %q", field, got, want)
+		}
+	}
+
+	// With the same number of literal segments, the pattern fixing the earlier segment wins
+	r = NewResolver()
+	r.SetCustomMessage("required.items.*.price", "items")
+	r.SetCustomMessage("required.*.0.price", "first")
+	if got := r.Resolve("required", "items.0.price", nil, nil); got != "items" {
+		t.Errorf("unexpected tie-break: %q", got)
+	}
+}
+
+func TestResolver_IndexPlaceholders(t *testing.T) {
+	r := NewResolver()
+	r.SetCustomMessage("required", "Item :index (#:position) needs :attribute")
+	if got := r.Resolve("required", "items.0.price", nil, nil); got != "Item 0 (#1) needs items.0.price" {
+		t.Errorf("unexpected index placeholders: %q", got)
+	}
+	// Placeholders are left untouched outside arrays
+	if got := r.Resolve("required", "name", nil, nil); got != "Item :index (#:position) needs name" {
+		t.Errorf("unexpected message outside arrays: %q", got)
+	}
+}
+
+func TestCatalogs_WildcardEntries(t *testing.T) {
+	catalog, err := ParseCatalog(language.English, []byte(`{
+		"required": "The :attribute field is required",
+		"custom": {"items.*.sku": {"required": "Every item needs a SKU"}},
+		"attributes": {"items.*.price": "Preis von Artikel :position"}
+	}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	r := NewResolverWithCatalogs(NewCatalogs(catalog))
+
+	if got := r.Resolve("required", "items.1.sku", nil, nil); got != "Every item needs a SKU" {
+		t.Errorf("unexpected catalog wildcard message: %q", got)
+	}
+	if got := r.Resolve("required", "items.1.price", nil, nil); got != "The Preis von Artikel 2 field is required" {
+		t.Errorf("unexpected catalog wildcard attribute: %q", got)
+	}
+}
@@ -0,0 +1,159 @@
+package message
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+// listSeparator joins the values of list placeholders such as :values
+const listSeparator = ", "
+
+// placeholderPattern matches placeholders such as :attribute, :other or :param0
+var placeholderPattern = regexp.MustCompile(`:[A-Za-z_][A-Za-z0-9_]*`)
+
+// formatMessage replaces the placeholders of a message template. Callers must hold the read lock.
+//
+// :attribute is the attribute name of the field, :field its path and :input the submitted value.
+// For array elements :index is the first index in the path and :position that index plus one.
+// :param0, :param1, ... are the raw rule parameters, and the parameter names declared in the
+// rule descriptor (e.g. :other, :min, :values) render the parameters they cover. Field and date
+// parameters render as attribute names, so a date naming another field reads like it, and
+// variadic parameters as a list. Without a descriptor,
+// named placeholders bind to the parameters in the order they appear, the last one taking the
+// remaining parameters. Named values in extra, such as reason parameters, take precedence.
+// Unknown placeholders are left untouched.
+func (r *Resolver) formatMessage(
+	message, rule, field string, value any, parameters []string, extra map[string]string,
+) string {
+	replacements := map[string]string{
+		"attribute": r.attributeName(field),
+		"field":     field,
+		"input":     formatInput(value),
+	}
+	for name, replacement := range indexPlaceholders(field) {
+		replacements[name] = replacement
+	}
+	for i, param := range parameters {
+		replacements["param"+strconv.Itoa(i)] = param
+	}
+	for name, replacement := range extra {
+		replacements[name] = replacement
+	}
+	if !r.namedParams(rule, parameters, replacements) {
+		positionalParams(message, parameters, replacements)
+	}
+
+	return replacePlaceholders(message, replacements)
+}
+
+// namedParams adds the placeholders declared by the rule descriptor to replacements and reports
+// whether the rule has a descriptor
+func (r *Resolver) namedParams(rule string, parameters []string, replacements map[string]string) bool {
+	if r.describer == nil {
+		return false
+	}
+	descriptor, ok := r.describer.Describe(rule)
+	if !ok {
+		return false
+	}
+
+	for i, spec := range descriptor.Params {
+		if i >= len(parameters) {
+			break
+		}
+		values := parameters[i : i+1]
+		if spec.Variadic {
+			values = parameters[i:]
+		}
+		if spec.Type == contract.ParamField || spec.Type == contract.ParamDate || spec.Type == contract.ParamOperand {
+			names := make([]string, len(values))
+			for j, other := range values {
+				names[j] = r.attributeName(other)
+			}
+			values = names
+		}
+		if name := spec.PlaceholderName(); replacements[name] == "" {
+			replacements[name] = strings.Join(values, listSeparator)
+		}
+	}
+	return true
+}
+
+// positionalParams binds the named placeholders of message to parameters in order of appearance
+func positionalParams(message string, parameters []string, replacements map[string]string) {
+	var names []string
+	for _, placeholder := range placeholderPattern.FindAllString(message, -1) {
+		name := placeholder[1:]
+		if _, bound := replacements[name]; !bound {
+			replacements[name] = ""
+			names = append(names, name)
+		}
+	}
+
+	for i, name := range names {
+		switch {
+		case i >= len(parameters):
+			delete(replacements, name)
+		case i == len(names)-1:
+			replacements[name] = strings.Join(parameters[i:], listSeparator)
+		default:
+			replacements[name] = parameters[i]
+		}
+	}
+}
+
+// attributeName returns the custom or localized attribute name of a field, or the field itself.
+// Names may be set for wildcard patterns such as "items.*.price" and may use the :index and
+// :position placeholders of the element, e.g. "price of item #:position".
+func (r *Resolver) attributeName(field string) string {
+	for _, layer := range r.layers() {
+		if name, exists := layer.attributes[field]; exists {
+			return replacePlaceholders(name, indexPlaceholders(field))
+		}
+		if _, name, exists := matchWildcard(layer.attributes, "", "", field); exists {
+			return replacePlaceholders(name, indexPlaceholders(field))
+		}
+	}
+	if name, exists := r.catalogs.Attribute(r.locale, field); exists {
+		return replacePlaceholders(name, indexPlaceholders(field))
+	}
+	return field
+}
+
+// replacePlaceholders replaces the placeholders of message found in replacements
+func replacePlaceholders(message string, replacements map[string]string) string {
+	if len(replacements) == 0 {
+		return message
+	}
+	return placeholderPattern.ReplaceAllStringFunc(message, func(placeholder string) string {
+		if replacement, ok := replacements[placeholder[1:]]; ok {
+			return replacement
+		}
+		return placeholder
+	})
+}
+
+// formatInput renders a submitted value for the :input placeholder
+func formatInput(value any) string {
+	if value == nil {
+		return ""
+	}
+	if s, ok := value.(string); ok {
+		return s
+	}
+
+	val := reflect.ValueOf(value)
+	if val.Kind() == reflect.Slice || val.Kind() == reflect.Array {
+		items := make([]string, val.Len())
+		for i := range items {
+			items[i] = formatInput(val.Index(i).Interface())
+		}
+		return strings.Join(items, listSeparator)
+	}
+	return fmt.Sprint(value)
+}
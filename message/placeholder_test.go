@@ -0,0 +1,90 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+type stubDescriber map[string]contract.RuleDescriptor
+
+func (s stubDescriber) Describe(name string) (contract.RuleDescriptor, bool) {
+	d, ok := s[name]
+	return d, ok
+}
+
+var testDescriptors = stubDescriber{
+	"prohibited_if": {Name: "prohibited_if", Params: []contract.ParamSpec{
+		{Name: "other", Type: contract.ParamField},
+		{Name: "values", Type: contract.ParamString, Variadic: true},
+	}},
+	"doesnt_start_with": {Name: "doesnt_start_with", Params: []contract.ParamSpec{
+		{Name: "prefixes", Type: contract.ParamString, Variadic: true, Placeholder: "values"},
+	}},
+	"required_with": {Name: "required_with", Params: []contract.ParamSpec{
+		{Name: "fields", Type: contract.ParamField, Variadic: true, Placeholder: "values"},
+	}},
+	"between": {Name: "between", Params: []contract.ParamSpec{
+		{Name: "min", Type: contract.ParamNumber},
+		{Name: "max", Type: contract.ParamNumber},
+	}},
+}
+
+func TestResolver_NamedPlaceholders(t *testing.T) {
+	r := NewResolver()
+	r.SetRuleDescriber(testDescriptors)
+	r.SetCustomAttribute("payment_type", "payment type")
+	r.SetCustomAttribute("last_name", "last name")
+
+	tests := []struct {
+		name   string
+		rule   string
+		value  any
+		params []string
+		msg    string
+		want   string
+	}{
+		{"other uses custom attribute", "prohibited_if", "x", []string{"payment_type", "cash", "card"},
+			"", "The coupon field is prohibited when payment type is cash, card"},
+		{"values renders a list", "doesnt_start_with", "foo", []string{"foo", "bar"},
+			"", "The coupon must not start with one of the following: foo, bar"},
+		{"field lists use attribute names", "required_with", nil, []string{"first_name", "last_name"},
+			"", "The coupon field is required when first_name, last name is present"},
+		{"min and max", "between", 1, []string{"2", "5"},
+			"", "The coupon must be between 2 and 5"},
+		{"input renders the value", "between", 7, []string{"2", "5"},
+			":input is not between :min and :max", "7 is not between 2 and 5"},
+		{"input renders slices", "doesnt_start_with", []any{"a", 1}, []string{"x"},
+			"got :input", "got a, 1"},
+		{"positional parameters still work", "between", 1, []string{"2", "5"},
+			":param0-:param1", "2-5"},
+		{"unknown placeholders are kept", "between", 1, []string{"2", "5"},
+			":attribute :unknown :max", "coupon :unknown 5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver := r.Clone().(*Resolver)
+			if tt.msg != "" {
+				resolver.SetCustomMessage(tt.rule, tt.msg)
+			}
+			if got := resolver.Resolve(tt.rule, "coupon", tt.value, tt.params); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolver_PositionalFallbackWithoutDescriptor(t *testing.T) {
+	r := NewResolver()
+	r.SetCustomMessage("custom_rule", ":attribute must be :value or one of :values")
+
+	got := r.Resolve("custom_rule", "size", nil, []string{"small", "medium", "large"})
+	if got != "size must be small or one of medium, large" {
+		t.Errorf("unexpected message: %q", got)
+	}
+
+	got = r.Resolve("custom_rule", "size", nil, nil)
+	if got != "size must be :value or one of :values" {
+		t.Errorf("placeholders without parameters must be kept: %q", got)
+	}
+}
@@ -0,0 +1,103 @@
+package message
+
+import "golang.org/x/text/language"
+
+// KeyOrder is the order of rule and field in field-specific message keys. Both orders are
+// accepted; the canonical one is tried first when a resolver holds keys in both forms.
+type KeyOrder int
+
+const (
+	// RuleFieldOrder writes field-specific keys as "<rule>.<field>", e.g. "required.email"
+	RuleFieldOrder KeyOrder = iota
+	// FieldRuleOrder writes field-specific keys as "<field>.<rule>", Laravel's form, e.g. "email.required"
+	FieldRuleOrder
+)
+
+// Key returns the field-specific message key of rule and field in this order
+func (o KeyOrder) Key(rule, field string) string {
+	if o == FieldRuleOrder {
+		return field + "." + rule
+	}
+	return rule + "." + field
+}
+
+// other returns the non-canonical order
+func (o KeyOrder) other() KeyOrder {
+	if o == FieldRuleOrder {
+		return RuleFieldOrder
+	}
+	return FieldRuleOrder
+}
+
+// affixes returns what surrounds a field pattern in keys of this order
+func (o KeyOrder) affixes(rule string) (prefix, suffix string) {
+	if o == FieldRuleOrder {
+		return "", "." + rule
+	}
+	return rule + ".", ""
+}
+
+// MessageSource names the layer of the precedence chain a message was taken from
+type MessageSource string
+
+// Layers of the precedence chain, from the highest to the lowest priority
+const (
+	// SourceRequestField is a field-specific message set on a request-scoped resolver
+	SourceRequestField MessageSource = "request_field"
+	// SourceRequestRule is a rule message set on a request-scoped resolver
+	SourceRequestRule MessageSource = "request_rule"
+	// SourceInstanceField is a field-specific message set on the validator instance
+	SourceInstanceField MessageSource = "instance_field"
+	// SourceInstanceRule is a rule message set on the validator instance
+	SourceInstanceRule MessageSource = "instance_rule"
+	// SourceCatalog is a message of the locale catalogs, including the fallback catalog
+	SourceCatalog MessageSource = "catalog"
+	// SourceDefault is the message reported by the rule itself or the generic fallback message
+	SourceDefault MessageSource = "default"
+)
+
+// Resolution describes how a message was resolved, for debugging message precedence
+type Resolution struct {
+	// Message is the final message with its placeholders replaced
+	Message string
+	// Template is the message before placeholders were replaced
+	Template string
+	// Source is the layer the template was taken from
+	Source MessageSource
+	// Key is the custom message or catalog key that matched, e.g. "email.required" or
+	// "required.items.*.price"; it is empty for the generic fallback message
+	Key string
+	// Locale is the catalog the template was taken from when Source is SourceCatalog
+	Locale language.Tag
+}
+
+// messageLayer holds the custom messages and attribute names of one layer of the precedence chain
+type messageLayer struct {
+	messages    map[string]string
+	attributes  map[string]string
+	fieldSource MessageSource
+	ruleSource  MessageSource
+}
+
+// newMessageLayer creates an empty layer reporting the given sources
+func newMessageLayer(fieldSource, ruleSource MessageSource) *messageLayer {
+	return &messageLayer{
+		messages:    make(map[string]string),
+		attributes:  make(map[string]string),
+		fieldSource: fieldSource,
+		ruleSource:  ruleSource,
+	}
+}
+
+// merge copies the entries of other into l, overriding existing ones. A nil layer is ignored.
+func (l *messageLayer) merge(other *messageLayer) {
+	if other == nil {
+		return
+	}
+	for k, v := range other.messages {
+		l.messages[k] = v
+	}
+	for k, v := range other.attributes {
+		l.attributes[k] = v
+	}
+}
@@ -0,0 +1,121 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"golang.org/x/text/language"
+)
+
+func TestResolver_PrecedenceChain(t *testing.T) {
+	instance := NewResolver()
+	instance.SetCustomMessage("required", "instance rule")
+	instance.SetCustomMessage("required.email", "instance field")
+
+	// A field-specific message wins over a rule-wide one regardless of insertion order
+	if got := instance.Explain("required", "email", nil, nil); got.Message != "instance field" ||
+		got.Source != SourceInstanceField || got.Key != "required.email" {
+		t.Errorf("unexpected instance field resolution: %+v", got)
+	}
+	if got := instance.Explain("required", "name", nil, nil); got.Source != SourceInstanceRule {
+		t.Errorf("expected instance rule, got %+v", got)
+	}
+
+	request := instance.Clone().(*Resolver)
+	request.SetCustomMessage("required", "request rule")
+	if got := request.Explain("required", "email", nil, nil); got.Message != "request rule" ||
+		got.Source != SourceRequestRule {
+		t.Errorf("expected request rule to beat instance field, got %+v", got)
+	}
+	request.SetCustomMessage("email.required", "request field")
+	if got := request.Explain("required", "email", nil, nil); got.Message != "request field" ||
+		got.Source != SourceRequestField || got.Key != "email.required" {
+		t.Errorf("expected request field, got %+v", got)
+	}
+
+	// The instance resolver is unaffected by the request layer
+	if got := instance.Resolve("required", "email", nil, nil); got != "instance field" {
+		t.Errorf("request messages leaked into the instance: %q", got)
+	}
+}
+
+func TestResolver_ExplainCatalogAndDefault(t *testing.T) {
+	r := NewResolver()
+	got := r.Explain("required", "name", nil, nil)
+	if got.Source != SourceCatalog || got.Key != "required" || got.Locale != language.English {
+		t.Errorf("unexpected catalog resolution: %+v", got)
+	}
+	if got.Message != "The name field is required" || got.Template != "The :attribute field is required" {
+		t.Errorf("unexpected catalog message: %+v", got)
+	}
+
+	got = r.Explain("no_such_rule", "name", nil, nil)
+	if got.Source != SourceDefault || got.Key != "" || got.Message != "The name field is invalid" {
+		t.Errorf("unexpected default resolution: %+v", got)
+	}
+}
+
+func TestResolver_RuleTextBeforeFallback(t *testing.T) {
+	r := NewResolver()
+	tenant := contract.NewReason("tenant", "need tenant", nil)
+	if got := r.ResolveReason("tenant", "a", nil, nil, tenant); got != "need tenant" {
+		t.Errorf("expected the rule's text, got %q", got)
+	}
+	required := contract.NewReason("required", "rule text", nil)
+	if got := r.ResolveReason("required", "a", nil, nil, required); got != "The a field is required" {
+		t.Errorf("expected the catalog message to win over the rule's text, got %q", got)
+	}
+	if got := r.Resolve("tenant", "a", nil, nil); got != "The a field is invalid" {
+		t.Errorf("expected the generic fallback without a rule message, got %q", got)
+	}
+}
+
+func TestResolver_KeyOrder(t *testing.T) {
+	r := NewResolver()
+	r.SetCustomMessage("required.email", "rule first")
+	r.SetCustomMessage("email.required", "field first")
+
+	if got := r.Resolve("required", "email", nil, nil); got != "rule first" {
+		t.Errorf("expected the default canonical order to win, got %q", got)
+	}
+	r.SetKeyOrder(FieldRuleOrder)
+	if got := r.Resolve("required", "email", nil, nil); got != "field first" {
+		t.Errorf("expected field-first keys to win, got %q", got)
+	}
+	if clone := r.Clone().(*Resolver); clone.KeyOrder() != FieldRuleOrder {
+		t.Error("expected the clone to keep the key order")
+	}
+
+	// Laravel's order also works for wildcard patterns
+	r = NewResolver()
+	r.SetCustomMessage("items.*.price.required", "Item #:position needs a price")
+	if got := r.Resolve("required", "items.1.price", nil, nil); got != "Item #2 needs a price" {
+		t.Errorf("unexpected field-first wildcard message: %q", got)
+	}
+
+	if got := FieldRuleOrder.Key("required", "email"); got != "email.required" {
+		t.Errorf("unexpected field-first key: %q", got)
+	}
+	if got := RuleFieldOrder.Key("required", "email"); got != "required.email" {
+		t.Errorf("unexpected rule-first key: %q", got)
+	}
+}
+
+func TestResolver_ReasonPrecedence(t *testing.T) {
+	instance := NewResolver()
+	instance.SetCustomMessage("password.symbols", "instance symbols")
+	request := instance.Clone().(*Resolver)
+	reason := contract.NewReason("password.symbols", "needs a symbol", nil)
+
+	if got := request.ResolveReason("password", "pw", "x", nil, reason); got != "instance symbols" {
+		t.Errorf("unexpected instance reason message: %q", got)
+	}
+	request.SetCustomMessage("password", "request rule")
+	if got := request.ResolveReason("password", "pw", "x", nil, reason); got != "request rule" {
+		t.Errorf("expected the request layer to win, got %q", got)
+	}
+	request.SetCustomMessage("pw.password.symbols", "request field reason")
+	if got := request.ResolveReason("password", "pw", "x", nil, reason); got != "request field reason" {
+		t.Errorf("expected the field-specific reason to win, got %q", got)
+	}
+}
@@ -1,184 +1,297 @@
 package message
 
 import (
-	"strings"
 	"sync"
 
 	"github.com/next-trace/scg-validator/contract"
-	"github.com/next-trace/scg-validator/utils"
+	"golang.org/x/text/language"
 )
 
+// fallbackMessage is used when no message is defined for a rule
+const fallbackMessage = "The :attribute field is invalid"
+
 // Resolver implements the MessageResolver interface
-// It provides request-scoped custom message and attribute resolution
+// It provides request-scoped custom message and attribute resolution.
+//
+// Messages are looked up along a fixed precedence chain, stopping at the first match:
+//
+//  1. per-request field-specific message ("<rule>.<field>" or "<field>.<rule>")
+//  2. per-request rule message
+//  3. instance field-specific message
+//  4. instance rule message
+//  5. the locale catalogs: field-specific messages, then rule messages
+//  6. the message reported by the rule, then the generic fallback message
+//
+// The rule's message is the one of the reason passed to ResolveReason. The engine passes rules
+// failing with a plain error as a reason keyed by the rule name and holding the error text, so
+// their text is used when no message is defined for the rule; Resolve has no such message.
+//
+// Messages set on a resolver from NewResolver form the instance layer. Clone and
+// NewRequestScopedResolver return request-scoped resolvers, whose setters fill the request layer
+// on top of the instance messages they were created from. Within a layer an exact field wins
+// over wildcard patterns such as "required.items.*.price".
 type Resolver struct {
-	customMessages   map[string]string
-	customAttributes map[string]string
-	defaultMessages  map[string]string
-	mu               sync.RWMutex
+	instance  *messageLayer
+	request   *messageLayer
+	describer contract.RuleDescriber
+	catalogs  *Catalogs
+	locale    language.Tag
+	keyOrder  KeyOrder
+	mu        sync.RWMutex
 }
 
+// Ensure Resolver implements the optional resolver interfaces
+var (
+	_ contract.LocaleResolver     = (*Resolver)(nil)
+	_ contract.DescriptorResolver = (*Resolver)(nil)
+	_ contract.ReasonResolver     = (*Resolver)(nil)
+	_ contract.AttributeResolver  = (*Resolver)(nil)
+	_ contract.TemplateResolver   = (*Resolver)(nil)
+)
+
 // NewResolver creates a new message resolver instance
 func NewResolver() *Resolver {
 	return &Resolver{
-		customMessages:   make(map[string]string),
-		customAttributes: make(map[string]string),
-		defaultMessages:  getDefaultMessages(),
+		instance: newMessageLayer(SourceInstanceField, SourceInstanceRule),
+		catalogs: DefaultCatalogs(),
+		locale:   language.English,
 	}
 }
 
+// NewResolverWithCatalogs creates a resolver that looks up messages in the given catalogs
+func NewResolverWithCatalogs(catalogs *Catalogs) *Resolver {
+	r := NewResolver()
+	r.catalogs = catalogs
+	return r
+}
+
 // NewRequestScopedResolver creates a new resolver for a specific request
 // This ensures isolation between different validation requests
 func NewRequestScopedResolver() *Resolver {
-	return NewResolver()
+	r := NewResolver()
+	r.request = newMessageLayer(SourceRequestField, SourceRequestRule)
+	return r
+}
+
+// Resolve creates a validation error message for the given rule, field, and parameters.
+// For size rules the catalog variant matching the kind of value is used, e.g. min.string.
+func (r *Resolver) Resolve(rule string, field string, value any, parameters []string) string {
+	return r.Explain(rule, field, value, parameters).Message
+}
+
+// Explain resolves a message like Resolve and reports the layer of the precedence chain and
+// the key it was taken from. It is meant for debugging unexpected messages.
+func (r *Resolver) Explain(rule string, field string, value any, parameters []string) Resolution {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	resolution := r.resolve(rule, field, value, nil)
+	resolution.Message = r.formatMessage(resolution.Template, rule, field, value, parameters, nil)
+	return resolution
+}
+
+// ResolveReason creates the message of a typed failure reason such as password.symbols. At each
+// layer of the precedence chain the reason key is tried before the rule, and catalogs may store
+// reasons flat or nested under their rule, e.g. "password": {"symbols": "..."}. Without any
+// other message, the reason's own message is used. The reason parameters are available as
+// named placeholders, and the fields it names render as their attribute names.
+func (r *Resolver) ResolveReason(
+	rule string, field string, value any, parameters []string, reason contract.Reason,
+) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	resolution := r.resolve(rule, field, value, &reason)
+	return r.formatMessage(resolution.Template, rule, field, value, parameters, r.reasonParams(reason, nil))
 }
 
-// Resolve creates a validation error message for the given rule, field, and parameters
-func (r *Resolver) Resolve(rule string, field string, parameters []string) string {
+// Template renders the message of a failure like Resolve, or like ResolveReason when reason is
+// not nil, but leaves the :attribute placeholder in place, so that the message can be rendered
+// again with another subject
+func (r *Resolver) Template(rule string, field string, value any, parameters []string, reason *contract.Reason) string {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	// Try to get custom message first
-	if customMsg, exists := r.customMessages[rule]; exists {
-		return r.formatMessage(customMsg, field, parameters)
+	keep := map[string]string{"attribute": ":attribute"}
+	resolution := r.resolve(rule, field, value, reason)
+	if reason != nil {
+		keep = r.reasonParams(*reason, keep)
+	}
+	return r.formatMessage(resolution.Template, rule, field, value, parameters, keep)
+}
+
+// reasonParams returns the placeholders of a reason added to extra: its parameters, and the
+// attribute names of the fields it names. Callers must hold the read lock.
+func (r *Resolver) reasonParams(reason contract.Reason, extra map[string]string) map[string]string {
+	if len(extra) == 0 && len(reason.Fields) == 0 {
+		return reason.Params
+	}
+	params := make(map[string]string, len(extra)+len(reason.Params)+len(reason.Fields))
+	for name, param := range reason.Params {
+		params[name] = param
+	}
+	for name, path := range reason.Fields {
+		params[name] = r.attributeName(path)
+	}
+	for name, param := range extra {
+		params[name] = param
+	}
+	return params
+}
+
+// resolve walks the precedence chain for a rule failure, trying the reason key before the rule
+// when a reason is given. Callers must hold the read lock.
+func (r *Resolver) resolve(rule, field string, value any, reason *contract.Reason) Resolution {
+	keys := []string{rule}
+	if reason != nil {
+		keys = []string{reason.Key, rule}
 	}
 
-	// Try to get field-specific custom message (rule.field format)
-	fieldSpecificKey := rule + "." + field
-	if customMsg, exists := r.customMessages[fieldSpecificKey]; exists {
-		return r.formatMessage(customMsg, field, parameters)
+	for _, layer := range r.layers() {
+		for _, key := range keys {
+			if matched, template, ok := fieldEntry(layer.messages, key, field, r.keyOrder); ok {
+				return Resolution{Template: template, Source: layer.fieldSource, Key: matched}
+			}
+		}
+		for _, key := range keys {
+			if template, ok := layer.messages[key]; ok {
+				return Resolution{Template: template, Source: layer.ruleSource, Key: key}
+			}
+		}
 	}
 
-	// Fall back to default message
-	if defaultMsg, exists := r.defaultMessages[rule]; exists {
-		return r.formatMessage(defaultMsg, field, parameters)
+	if entry, ok := r.catalogEntry(rule, field, value, reason); ok {
+		return Resolution{Template: entry.message, Source: SourceCatalog, Key: entry.key, Locale: entry.locale}
+	}
+	if reason != nil && reason.Message != "" {
+		return Resolution{Template: reason.Message, Source: SourceDefault, Key: reason.Key}
 	}
 
 	// Ultimate fallback
-	return r.formatMessage("The :attribute field is invalid", field, parameters)
+	return Resolution{Template: fallbackMessage, Source: SourceDefault}
+}
+
+// catalogEntry looks up the catalogs of the active locale: field-specific messages before rule
+// messages, and the reason key before the rule. Callers must hold the read lock.
+func (r *Resolver) catalogEntry(rule, field string, value any, reason *contract.Reason) (catalogEntry, bool) {
+	if reason != nil {
+		if entry, ok := r.catalogs.fieldEntry(r.locale, reason.Key, field, r.keyOrder); ok {
+			return entry, true
+		}
+		if entry, ok := r.catalogs.reasonEntry(r.locale, reason.Key); ok {
+			return entry, true
+		}
+	}
+	if entry, ok := r.catalogs.fieldEntry(r.locale, rule, field, r.keyOrder); ok {
+		return entry, true
+	}
+	return r.catalogs.messageEntry(r.locale, rule, sizeKind(rule, value))
 }
 
-// SetCustomMessage sets a custom message for a rule
+// layers returns the custom message layers from the highest priority to the lowest
+func (r *Resolver) layers() []*messageLayer {
+	if r.request == nil {
+		return []*messageLayer{r.instance}
+	}
+	return []*messageLayer{r.request, r.instance}
+}
+
+// own returns the layer the setters write to: the request layer of request-scoped resolvers and
+// the instance layer otherwise
+func (r *Resolver) own() *messageLayer {
+	if r.request != nil {
+		return r.request
+	}
+	return r.instance
+}
+
+// sizeKind returns the kind of value for size rules, which selects their message variant
+func sizeKind(rule string, value any) string {
+	if !contract.IsSizeRule(rule) {
+		return ""
+	}
+	return string(contract.KindOf(value))
+}
+
+// SetCustomMessage sets a custom message for a rule. Keys are rule names, reason keys such as
+// "password.symbols" or field-specific keys in either order: "<rule>.<field>" or "<field>.<rule>".
 func (r *Resolver) SetCustomMessage(rule string, message string) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	r.customMessages[rule] = message
+	r.own().messages[rule] = message
 }
 
 // SetCustomAttribute sets a custom attribute name for a field
 func (r *Resolver) SetCustomAttribute(field string, attribute string) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	r.customAttributes[field] = attribute
+	r.own().attributes[field] = attribute
 }
 
-// formatMessage formats the message by replacing placeholders
-func (r *Resolver) formatMessage(message string, field string, parameters []string) string {
-	// Replace :attribute with custom attribute name or field name
-	attributeName := field
-	if customAttr, exists := r.customAttributes[field]; exists {
-		attributeName = customAttr
-	}
+// SetKeyOrder sets the canonical order of field-specific message keys, which is tried first
+// when keys exist in both orders. The default is RuleFieldOrder.
+func (r *Resolver) SetKeyOrder(order KeyOrder) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.keyOrder = order
+}
 
-	message = strings.ReplaceAll(message, ":attribute", attributeName)
-	message = strings.ReplaceAll(message, ":field", field)
+// KeyOrder returns the canonical order of field-specific message keys
+func (r *Resolver) KeyOrder() KeyOrder {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.keyOrder
+}
 
-	// Replace parameter placeholders
-	for i, param := range parameters {
-		message = utils.ReplacePlaceholder(message, i, param)
-	}
+// Attribute returns the attribute name used for field in messages
+func (r *Resolver) Attribute(field string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.attributeName(field)
+}
 
-	return message
+// SetRuleDescriber sets where the parameter names behind named placeholders are looked up
+func (r *Resolver) SetRuleDescriber(describer contract.RuleDescriber) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.describer = describer
 }
 
-// Clone creates a copy of the resolver for request isolation
-func (r *Resolver) Clone() contract.MessageResolver {
+// SetLocale selects the locale used to look up messages and attribute names
+func (r *Resolver) SetLocale(tag language.Tag) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.locale = tag
+}
+
+// Locale returns the locale used to look up messages and attribute names
+func (r *Resolver) Locale() language.Tag {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
+	return r.locale
+}
 
-	newResolver := NewResolver()
+// Clone creates a request-scoped copy of the resolver for request isolation. All messages and
+// attributes of the receiver form the instance layer of the copy.
+func (r *Resolver) Clone() contract.MessageResolver {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 
-	// Copy custom messages
-	for k, v := range r.customMessages {
-		newResolver.customMessages[k] = v
-	}
+	newResolver := NewRequestScopedResolver()
+	newResolver.catalogs = r.catalogs
+	newResolver.locale = r.locale
+	newResolver.describer = r.describer
+	newResolver.keyOrder = r.keyOrder
 
-	// Copy custom attributes
-	for k, v := range r.customAttributes {
-		newResolver.customAttributes[k] = v
-	}
+	// Copy custom messages and attributes, request entries overriding instance ones
+	newResolver.instance.merge(r.instance)
+	newResolver.instance.merge(r.request)
 
 	return newResolver
 }
 
-// getDefaultMessages returns the default validation messages
-func getDefaultMessages() map[string]string {
-	return map[string]string{
-		"accepted":             "The :attribute must be accepted",
-		"accepted_if":          "The :attribute must be accepted when :param0 is :param1",
-		"accepted_unless":      "The :attribute must be accepted unless :param0 is :param1",
-		"accepted_with":        "The :attribute must be accepted when :param0 is present",
-		"accepted_without":     "The :attribute must be accepted when :param0 is not present",
-		"declined":             "The :attribute must be declined",
-		"declined_if":          "The :attribute must be declined when :param0 is :param1",
-		"declined_unless":      "The :attribute must be declined unless :param0 is :param1",
-		"declined_with":        "The :attribute must be declined when :param0 is present",
-		"declined_without":     "The :attribute must be declined when :param0 is not present",
-		"boolean":              "The :attribute must be true or false",
-		"between":              "The :attribute must be between :param0 and :param1",
-		"different":            "The :attribute and :param0 must be different",
-		"ends_with":            "The :attribute must end with one of the following: :param0",
-		"bail":                 "Stop validation on first failure",
-		"exists":               "The selected :attribute is invalid",
-		"date":                 "The :attribute is not a valid date",
-		"after":                "The :attribute must be a date after :param0",
-		"after_or_equal":       "The :attribute must be a date after or equal to :param0",
-		"before":               "The :attribute must be a date before :param0",
-		"before_or_equal":      "The :attribute must be a date before or equal to :param0",
-		"date_equals":          "The :attribute must be a date equal to :param0",
-		"date_format":          "The :attribute does not match the format :param0",
-		"decimal":              "The :attribute must have :param0 decimal places",
-		"active_url":           "The :attribute must be a valid URL",
-		"confirmed":            "The :attribute confirmation does not match",
-		"alpha":                "The :attribute may only contain letters",
-		"alphanum":             "The :attribute may only contain letters and numbers",
-		"alpha_dash":           "The :attribute may only contain letters, numbers, dashes and underscores",
-		"email":                "The :attribute must be a valid email address",
-		"ascii":                "The :attribute must only contain ASCII characters",
-		"current_password":     "The :attribute is incorrect",
-		"doesnt_start_with":    "The :attribute must not start with one of the following: :param0",
-		"doesnt_end_with":      "The :attribute must not end with one of the following: :param0",
-		"required":             "The :attribute field is required",
-		"required_if":          "The :attribute field is required when :param0 is :param1",
-		"required_unless":      "The :attribute field is required unless :param0 is :param1",
-		"required_with":        "The :attribute field is required when :param0 is present",
-		"required_without":     "The :attribute field is required when :param0 is not present",
-		"required_with_all":    "The :attribute field is required when :param0 are present",
-		"required_without_all": "The :attribute field is required when none of :param0 are present",
-		"prohibited":           "The :attribute field is prohibited",
-		"prohibited_if":        "The :attribute field is prohibited when :param0 is :param1",
-		"prohibited_unless":    "The :attribute field is prohibited unless :param0 is :param1",
-		"prohibits":            "The :attribute field prohibits :param0 from being present",
-		"filled":               "The :attribute field must have a value",
-		"present":              "The :attribute field must be present",
-		"sometimes":            "The :attribute field is sometimes required",
-		"nullable":             "The :attribute field may be null",
-		"numeric":              "The :attribute must be a number",
-		"integer":              "The :attribute must be an integer",
-		"multiple_of":          "The :attribute must be a multiple of :param0",
-		"lowercase":            "The :attribute must be lowercase",
-		"uppercase":            "The :attribute must be uppercase",
-		"ulid":                 "The :attribute must be a valid ULID",
-		"slug":                 "The :attribute must be a valid slug",
-		"file":                 "The :attribute must be a file",
-		"image":                "The :attribute must be an image",
-		"mimes":                "The :attribute must be a file of type: :param0",
-		"min":                  "The :attribute must be at least :param0",
-		"max":                  "The :attribute may not be greater than :param0",
-		"size":                 "The :attribute must be :param0",
-		"gt":                   "The :attribute must be greater than :param0",
-		"lt":                   "The :attribute must be less than :param0",
-		"gte":                  "The :attribute must be greater than or equal to :param0",
-		"lte":                  "The :attribute must be less than or equal to :param0",
-		"same":                 "The :attribute and :param0 must match",
-	}
+// DefaultMessage returns the built-in English message template for a rule. For size rules this
+// is the numeric variant.
+func DefaultMessage(rule string) (string, bool) {
+	return englishCatalog.lookup(rule, "")
 }
@@ -3,27 +3,29 @@ package message
 import (
 	"strings"
 	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
 )
 
 func TestResolver_DefaultAndCustomMessages(t *testing.T) {
 	r := NewResolver()
 
 	// default message with params
-	msg := r.Resolve("between", "age", []string{"18", "65"})
+	msg := r.Resolve("between", "age", 30, []string{"18", "65"})
 	if !strings.Contains(msg, "age") || !strings.Contains(msg, "18") || !strings.Contains(msg, "65") {
 		t.Fatalf("unexpected default message: %q", msg)
 	}
 
 	// custom message overrides
 	r.SetCustomMessage("between", ":attribute must be in [:param0, :param1]")
-	msg = r.Resolve("between", "age", []string{"18", "65"})
+	msg = r.Resolve("between", "age", 30, []string{"18", "65"})
 	if msg != "age must be in [18, 65]" {
 		t.Fatalf("unexpected custom message: %q", msg)
 	}
 
 	// field-specific custom message
 	r.SetCustomMessage("required.email", "Email is required")
-	msg = r.Resolve("required", "email", nil)
+	msg = r.Resolve("required", "email", nil, nil)
 	if msg != "Email is required" {
 		t.Fatalf("unexpected field custom message: %q", msg)
 	}
@@ -33,13 +35,13 @@ func TestResolver_CustomAttributeAndFallback(t *testing.T) {
 	r := NewResolver()
 	r.SetCustomAttribute("username", "User Name")
 
-	msg := r.Resolve("required", "username", nil)
+	msg := r.Resolve("required", "username", nil, nil)
 	if !strings.Contains(msg, "User Name") {
 		t.Fatalf("custom attribute not applied: %q", msg)
 	}
 
 	// unknown rule -> ultimate fallback
-	msg = r.Resolve("no_such_rule", "x", []string{"p"})
+	msg = r.Resolve("no_such_rule", "x", nil, []string{"p"})
 	if !strings.Contains(msg, "x") || !strings.Contains(msg, "invalid") {
 		t.Fatalf("unexpected fallback message: %q", msg)
 	}
@@ -57,8 +59,8 @@ func TestResolver_CloneIsolation(t *testing.T) {
 	clone.SetCustomAttribute("field", "Clone Field")
 
 	// original should remain intact for formatting
-	origMsg := r.Resolve("alpha", "field", nil)
-	clMsg := clone.Resolve("alpha", "field", nil)
+	origMsg := r.Resolve("alpha", "field", nil, nil)
+	clMsg := clone.Resolve("alpha", "field", nil, nil)
 	if origMsg == clMsg {
 		t.Fatalf("expected clone to be isolated from original")
 	}
@@ -66,3 +68,78 @@ func TestResolver_CloneIsolation(t *testing.T) {
 		t.Fatalf("attributes not isolated: orig=%q clone=%q", origMsg, clMsg)
 	}
 }
+
+func TestResolver_SizeRuleVariants(t *testing.T) {
+	r := NewResolver()
+
+	tests := []struct {
+		name  string
+		rule  string
+		value any
+		want  string
+	}{
+		{"numeric", "min", 2, "The age must be at least 3"},
+		{"string", "min", "ab", "The age must be at least 3 characters"},
+		{"array", "max", []string{"a", "b", "c", "d"}, "The age may not have more than 3 items"},
+		{"map counts as array", "size", map[string]any{}, "The age must contain 3 items"},
+		{"unknown kind uses numeric form", "gt", true, "The age must be greater than 3"},
+		{"non-size rule ignores kind", "required", "", "The age field is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := r.Resolve(tt.rule, "age", tt.value, []string{"3"}); got != tt.want {
+				t.Errorf("Resolve(%s, %v) = %q, want %q", tt.rule, tt.value, got, tt.want)
+			}
+		})
+	}
+
+	// custom messages still take precedence over variants
+	r.SetCustomMessage("min", ":attribute too small")
+	if got := r.Resolve("min", "age", "ab", []string{"3"}); got != "age too small" {
+		t.Errorf("unexpected custom message: %q", got)
+	}
+
+	if msg, _ := DefaultMessage("between"); msg != "The :attribute must be between :min and :max" {
+		t.Errorf("unexpected default message for size rule: %q", msg)
+	}
+}
+
+func TestResolver_ResolveReason(t *testing.T) {
+	symbols := contract.NewReason("password.symbols", "password must contain at least one symbol", nil)
+	missing := contract.NewReason("exist.missing", "7 does not exist in users.id",
+		map[string]string{"value": "7", "table": "users"})
+	dateFormat := contract.NewReason("date.format", "cannot parse", map[string]string{"format": "2006-01-02"})
+
+	r := NewResolver()
+	if got := r.ResolveReason("password", "pin", "x", nil, symbols); got != "The pin must contain at least one symbol" {
+		t.Errorf("expected nested catalog message, got %q", got)
+	}
+	if got := r.ResolveReason("exist", "user_id", 7, nil, missing); got != "7 does not exist in users.id" {
+		t.Errorf("expected the reason's own message, got %q", got)
+	}
+	if got := r.ResolveReason("date", "born", "x", nil, dateFormat); got != "The born is not a valid date" {
+		t.Errorf("expected the rule message before the reason's own message, got %q", got)
+	}
+
+	r.SetCustomMessage("exist", "Unknown :attribute")
+	if got := r.ResolveReason("exist", "user_id", 7, nil, missing); got != "Unknown user_id" {
+		t.Errorf("custom rule message must apply to its reasons, got %q", got)
+	}
+	r.SetCustomMessage("exist.missing", ":value is not in :table")
+	if got := r.ResolveReason("exist", "user_id", 7, nil, missing); got != "7 is not in users" {
+		t.Errorf("expected reason parameters as placeholders, got %q", got)
+	}
+	r.SetCustomMessage("date.format.born", "Use :format")
+	if got := r.ResolveReason("date", "born", "x", nil, dateFormat); got != "Use 2006-01-02" {
+		t.Errorf("expected field-specific reason message, got %q", got)
+	}
+}
+
+func TestResolver_ResolveReason_CustomRuleMessageWins(t *testing.T) {
+	r := NewResolver()
+	r.SetCustomMessage("password", "Choose a stronger :attribute")
+	reason := contract.NewReason("password.symbols", "password must contain at least one symbol", nil)
+	if got := r.ResolveReason("password", "pin", "x", nil, reason); got != "Choose a stronger pin" {
+		t.Errorf("custom rule message must win over catalog reasons, got %q", got)
+	}
+}
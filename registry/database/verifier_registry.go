@@ -7,8 +7,9 @@ import (
 )
 
 var (
-	verifiers = make(map[string]contract.PresenceVerifier)
-	lock      = &sync.RWMutex{}
+	verifiers        = make(map[string]contract.PresenceVerifier)
+	contextVerifiers = make(map[string]contract.ContextPresenceVerifier)
+	lock             = &sync.RWMutex{}
 )
 
 // RegisterPresenceVerifier registers a PresenceVerifier for a given table.
@@ -30,3 +31,57 @@ func FindPresenceVerifier(table string) (contract.PresenceVerifier, bool) {
 	verifier, ok := verifiers[table]
 	return verifier, ok
 }
+
+// RegisterContextPresenceVerifier registers a context-aware PresenceVerifier for a given table.
+// It takes precedence over a PresenceVerifier registered for the same table.
+func RegisterContextPresenceVerifier(table string, verifier contract.ContextPresenceVerifier) {
+	lock.Lock()
+	defer lock.Unlock()
+	if verifier == nil {
+		panic("nil verifier registered")
+	}
+	contextVerifiers[table] = verifier
+}
+
+// FindContextPresenceVerifier finds a context-aware verifier for a given table.
+// Verifiers registered through RegisterPresenceVerifier are adapted transparently.
+func FindContextPresenceVerifier(table string) (contract.ContextPresenceVerifier, bool) {
+	lock.RLock()
+	defer lock.RUnlock()
+	if verifier, ok := contextVerifiers[table]; ok {
+		return verifier, true
+	}
+	if verifier, ok := verifiers[table]; ok {
+		return contract.AdaptPresenceVerifier(verifier), true
+	}
+	return nil, false
+}
+
+// FindBatchPresenceVerifier returns the batch capability of the verifier registered for a table.
+// It reports false when no verifier is registered or the verifier cannot check values in batches.
+func FindBatchPresenceVerifier(table string) (contract.ContextBatchPresenceVerifier, bool) {
+	lock.RLock()
+	defer lock.RUnlock()
+	if verifier, ok := contextVerifiers[table]; ok {
+		if batch, ok := contract.AdaptBatchPresenceVerifier(verifier); ok {
+			return batch, true
+		}
+	}
+	if verifier, ok := verifiers[table]; ok {
+		return contract.AdaptBatchPresenceVerifier(verifier)
+	}
+	return nil, false
+}
+
+// FindUniqueExceptVerifier returns the verifier registered for a table if it can ignore a row
+// when checking uniqueness
+func FindUniqueExceptVerifier(table string) (contract.UniqueExceptVerifier, bool) {
+	lock.RLock()
+	defer lock.RUnlock()
+	if verifier, ok := contextVerifiers[table]; ok {
+		except, ok := verifier.(contract.UniqueExceptVerifier)
+		return except, ok
+	}
+	except, ok := verifiers[table].(contract.UniqueExceptVerifier)
+	return except, ok
+}
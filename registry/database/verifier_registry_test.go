@@ -1,6 +1,8 @@
 package database
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/next-trace/scg-validator/contract"
@@ -29,3 +31,78 @@ func TestPresenceVerifierRegistry(t *testing.T) {
 	// interface check
 	var _ contract.PresenceVerifier = v
 }
+
+type tenantKey struct{}
+
+type fakeContextPresence struct{}
+
+func (f fakeContextPresence) ExistsContext(ctx context.Context, _, _ string, _ any) (bool, error) {
+	return ctx.Value(tenantKey{}) == "acme", nil
+}
+
+func (f fakeContextPresence) UniqueContext(ctx context.Context, _, _ string, _ any) (bool, error) {
+	return false, ctx.Err()
+}
+
+func TestContextPresenceVerifierRegistry(t *testing.T) {
+	RegisterContextPresenceVerifier("tenants", fakeContextPresence{})
+	got, ok := FindContextPresenceVerifier("tenants")
+	if !ok {
+		t.Fatal("expected registered context verifier")
+	}
+	ctx := context.WithValue(context.Background(), tenantKey{}, "acme")
+	if e, err := got.ExistsContext(ctx, "tenants", "id", 1); err != nil || !e {
+		t.Fatalf("expected request-scoped value to reach verifier: %v %v", e, err)
+	}
+
+	// legacy verifiers are adapted and honour cancellation
+	RegisterPresenceVerifier("legacy", fakePresence{exists: true})
+	adapted, ok := FindContextPresenceVerifier("legacy")
+	if !ok {
+		t.Fatal("expected legacy verifier to be adapted")
+	}
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := adapted.ExistsContext(canceled, "legacy", "id", 1); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected canceled error, got %v", err)
+	}
+	if _, ok := FindContextPresenceVerifier("missing"); ok {
+		t.Fatal("unexpected ok for missing table")
+	}
+}
+
+type fakeBatchPresence struct{ fakePresence }
+
+func (f fakeBatchPresence) ExistsMany(_, _ string, values []any) (map[any]bool, error) {
+	found := make(map[any]bool, len(values))
+	for _, value := range values {
+		found[value] = true
+	}
+	return found, nil
+}
+
+func TestBatchPresenceVerifierRegistry(t *testing.T) {
+	RegisterPresenceVerifier("batch", fakeBatchPresence{})
+	batch, ok := FindBatchPresenceVerifier("batch")
+	if !ok {
+		t.Fatal("expected batch capability to be detected")
+	}
+	found, err := batch.ExistsManyContext(context.Background(), "batch", "id", []any{1, 2})
+	if err != nil || !found[1] || !found[2] {
+		t.Fatalf("unexpected batch result: %v %v", found, err)
+	}
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := batch.ExistsManyContext(canceled, "batch", "id", []any{1}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected canceled error, got %v", err)
+	}
+
+	RegisterPresenceVerifier("single", fakePresence{})
+	if _, ok := FindBatchPresenceVerifier("single"); ok {
+		t.Fatal("did not expect batch capability for a plain verifier")
+	}
+	if _, ok := FindBatchPresenceVerifier("missing"); ok {
+		t.Fatal("unexpected ok for missing table")
+	}
+}
@@ -7,8 +7,9 @@ import (
 )
 
 var (
-	passwordVerifiers = make(map[string]contract.PasswordVerifier)
-	passwordLock      = &sync.RWMutex{}
+	passwordVerifiers        = make(map[string]contract.PasswordVerifier)
+	contextPasswordVerifiers = make(map[string]contract.ContextPasswordVerifier)
+	passwordLock             = &sync.RWMutex{}
 )
 
 // RegisterPasswordVerifier registers a PasswordVerifier for a given key (e.g., "default").
@@ -25,3 +26,25 @@ func FindPasswordVerifier(key string) (contract.PasswordVerifier, bool) {
 	v, ok := passwordVerifiers[key]
 	return v, ok
 }
+
+// RegisterContextPasswordVerifier registers a context-aware PasswordVerifier for a given key.
+// It takes precedence over a PasswordVerifier registered for the same key.
+func RegisterContextPasswordVerifier(key string, verifier contract.ContextPasswordVerifier) {
+	passwordLock.Lock()
+	defer passwordLock.Unlock()
+	contextPasswordVerifiers[key] = verifier
+}
+
+// FindContextPasswordVerifier finds a context-aware verifier for a given key.
+// Verifiers registered through RegisterPasswordVerifier are adapted transparently.
+func FindContextPasswordVerifier(key string) (contract.ContextPasswordVerifier, bool) {
+	passwordLock.RLock()
+	defer passwordLock.RUnlock()
+	if v, ok := contextPasswordVerifiers[key]; ok && v != nil {
+		return v, true
+	}
+	if v, ok := passwordVerifiers[key]; ok && v != nil {
+		return contract.AdaptPasswordVerifier(v), true
+	}
+	return nil, false
+}
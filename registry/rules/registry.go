@@ -2,6 +2,8 @@
 package rules
 
 import (
+	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/next-trace/scg-validator/contract"
@@ -12,8 +14,11 @@ type Option func(*contract.Config)
 
 // Registry holds all available rule creators
 type Registry struct {
-	creators map[string]contract.RuleCreator
-	mu       sync.RWMutex
+	creators    map[string]contract.RuleCreator
+	implicit    map[string]bool
+	descriptors map[string]contract.RuleDescriptor
+	aliases     map[string]string
+	mu          sync.RWMutex
 }
 
 // Registry implements contract.Registry interface for rule management
@@ -22,7 +27,10 @@ var _ contract.Registry = (*Registry)(nil)
 // NewRegistry creates a new rule registry
 func NewRegistry() *Registry {
 	return &Registry{
-		creators: make(map[string]contract.RuleCreator),
+		creators:    make(map[string]contract.RuleCreator),
+		implicit:    make(map[string]bool),
+		descriptors: make(map[string]contract.RuleDescriptor),
+		aliases:     make(map[string]string),
 	}
 }
 
@@ -31,26 +39,146 @@ func (r *Registry) Register(name string, creator contract.RuleCreator) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	r.creators[name] = creator
+	delete(r.implicit, name)
+	delete(r.descriptors, name)
+	delete(r.aliases, name)
 	return nil
 }
 
-// Get retrieves a rule creator by name
+// RegisterImplicit registers a rule creator whose rules run even when the field is missing or empty
+func (r *Registry) RegisterImplicit(name string, creator contract.RuleCreator) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.creators[name] = creator
+	r.implicit[name] = true
+	delete(r.descriptors, name)
+	delete(r.aliases, name)
+	return nil
+}
+
+// RegisterDescriptor registers a rule creator with its descriptor
+func (r *Registry) RegisterDescriptor(descriptor contract.RuleDescriptor, creator contract.RuleCreator) error {
+	if descriptor.Name == "" {
+		return fmt.Errorf("%w: descriptor without a name", contract.ErrInvalidRule)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.creators[descriptor.Name] = creator
+	r.descriptors[descriptor.Name] = descriptor
+	delete(r.aliases, descriptor.Name)
+	if descriptor.Implicit {
+		r.implicit[descriptor.Name] = true
+	} else {
+		delete(r.implicit, descriptor.Name)
+	}
+	return nil
+}
+
+// RegisterAlias makes alias resolve to the registered rule name. Registering a rule under the
+// alias later replaces the alias.
+func (r *Registry) RegisterAlias(alias, name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.creators[alias]; exists {
+		return fmt.Errorf("%w: alias %s is a registered rule", contract.ErrInvalidRule, alias)
+	}
+	if _, exists := r.creators[name]; !exists {
+		return fmt.Errorf("%w: alias %s of unknown rule %s", contract.ErrRuleNotFound, alias, name)
+	}
+	r.aliases[alias] = name
+	return nil
+}
+
+// Canonical returns the rule name an alias resolves to, or name itself when it is not an alias
+func (r *Registry) Canonical(name string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.canonical(name)
+}
+
+// canonical resolves an alias; callers must hold the read lock
+func (r *Registry) canonical(name string) string {
+	if target, ok := r.aliases[name]; ok {
+		return target
+	}
+	return name
+}
+
+// Describe returns the descriptor of the rule with the given name or alias
+func (r *Registry) Describe(name string) (contract.RuleDescriptor, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.describe(name)
+}
+
+// Descriptors returns the descriptors of all registered rules sorted by name
+func (r *Registry) Descriptors() []contract.RuleDescriptor {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	descriptors := make([]contract.RuleDescriptor, 0, len(r.creators))
+	for name := range r.creators {
+		descriptor, _ := r.describe(name)
+		descriptors = append(descriptors, descriptor)
+	}
+	sort.Slice(descriptors, func(i, j int) bool { return descriptors[i].Name < descriptors[j].Name })
+	return descriptors
+}
+
+// describe looks up a descriptor and fills in its aliases; callers must hold the read lock
+func (r *Registry) describe(name string) (contract.RuleDescriptor, bool) {
+	name = r.canonical(name)
+	if _, exists := r.creators[name]; !exists {
+		return contract.RuleDescriptor{}, false
+	}
+	descriptor, ok := r.descriptors[name]
+	if !ok {
+		// Without a descriptor nothing is known about the parameters, so any are accepted
+		descriptor = contract.RuleDescriptor{
+			Name: name,
+			Params: []contract.ParamSpec{
+				{Name: "params", Type: contract.ParamString, Optional: true, Variadic: true},
+			},
+			Implicit: r.implicit[name],
+		}
+	}
+	descriptor.Aliases = nil
+	for alias, target := range r.aliases {
+		if target == name {
+			descriptor.Aliases = append(descriptor.Aliases, alias)
+		}
+	}
+	sort.Strings(descriptor.Aliases)
+	return descriptor, true
+}
+
+// IsImplicit reports whether the rule with the given name or alias was registered as implicit
+func (r *Registry) IsImplicit(name string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.implicit[r.canonical(name)]
+}
+
+// Get retrieves a rule creator by name or alias
 func (r *Registry) Get(name string) (contract.RuleCreator, bool) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	creator, exists := r.creators[name]
+	creator, exists := r.creators[r.canonical(name)]
 	return creator, exists
 }
 
-// Has checks if a rule with the given name exists
+// Has checks if a rule with the given name or alias exists
 func (r *Registry) Has(name string) bool {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	_, exists := r.creators[name]
+	_, exists := r.creators[r.canonical(name)]
 	return exists
 }
 
-// List returns all registered rule names
+// List returns all registered rule names, without aliases
 func (r *Registry) List() []string {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
@@ -77,5 +205,14 @@ func (r *Registry) Clone() contract.Registry {
 	for name, creator := range r.creators {
 		newRegistry.creators[name] = creator
 	}
+	for name, implicit := range r.implicit {
+		newRegistry.implicit[name] = implicit
+	}
+	for name, descriptor := range r.descriptors {
+		newRegistry.descriptors[name] = descriptor
+	}
+	for alias, name := range r.aliases {
+		newRegistry.aliases[alias] = name
+	}
 	return newRegistry
 }
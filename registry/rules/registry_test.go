@@ -1,6 +1,7 @@
 package rules
 
 import (
+	"errors"
 	"sort"
 	"testing"
 
@@ -49,3 +50,102 @@ func TestRegistry_RegisterGetHasListClone(t *testing.T) {
 		t.Fatal("original registry should not have new entry from clone")
 	}
 }
+
+func TestRegistry_ImplicitRules(t *testing.T) {
+	r := NewRegistry()
+	creator := func(_ []string) (contract.Rule, error) { return dummyRule{}, nil }
+
+	_ = r.RegisterImplicit("implicit_dummy", creator)
+	_ = r.Register("dummy", creator)
+	if !r.IsImplicit("implicit_dummy") || r.IsImplicit("dummy") || r.IsImplicit("missing") {
+		t.Fatal("unexpected implicit flags")
+	}
+
+	clone := r.Clone()
+	if !clone.IsImplicit("implicit_dummy") {
+		t.Fatal("expected clone to keep implicit flag")
+	}
+
+	// re-registering as a regular rule clears the flag
+	_ = r.Register("implicit_dummy", creator)
+	if r.IsImplicit("implicit_dummy") {
+		t.Fatal("expected implicit flag to be cleared")
+	}
+}
+
+func TestRegistry_Descriptors(t *testing.T) {
+	r := NewRegistry()
+	creator := func(_ []string) (contract.Rule, error) { return dummyRule{}, nil }
+	descriptor := contract.RuleDescriptor{Name: "dummy", Category: "custom", Implicit: true}
+	if err := r.RegisterDescriptor(descriptor, creator); err != nil {
+		t.Fatalf("register error: %v", err)
+	}
+	_ = r.Register("plain", creator)
+
+	got, ok := r.Describe("dummy")
+	if !ok || got.Category != "custom" || !r.IsImplicit("dummy") {
+		t.Fatalf("unexpected descriptor: %+v", got)
+	}
+	plain, ok := r.Describe("plain")
+	if !ok || plain.Name != "plain" || plain.CheckParams([]string{"a", "b"}) != nil {
+		t.Fatalf("expected permissive fallback descriptor, got %+v", plain)
+	}
+	if _, ok := r.Describe("missing"); ok {
+		t.Fatal("expected no descriptor for missing rule")
+	}
+
+	all := r.Descriptors()
+	if len(all) != 2 || all[0].Name != "dummy" || all[1].Name != "plain" {
+		t.Fatalf("unexpected descriptors: %+v", all)
+	}
+	if clone, _ := r.Clone().Describe("dummy"); clone.Category != "custom" {
+		t.Fatal("expected clone to keep descriptors")
+	}
+
+	// re-registering without a descriptor drops the stale one
+	_ = r.Register("dummy", creator)
+	if got, _ := r.Describe("dummy"); got.Category != "" || got.Implicit {
+		t.Fatalf("expected descriptor to be cleared, got %+v", got)
+	}
+	if err := r.RegisterDescriptor(contract.RuleDescriptor{}, creator); err == nil {
+		t.Fatal("expected error for descriptor without name")
+	}
+}
+
+func TestRegistry_Aliases(t *testing.T) {
+	r := NewRegistry()
+	creator := func(_ []string) (contract.Rule, error) { return dummyRule{}, nil }
+	_ = r.RegisterImplicit("dummy", creator)
+
+	if err := r.RegisterAlias("dummy_alias", "missing"); !errors.Is(err, contract.ErrRuleNotFound) {
+		t.Fatalf("expected ErrRuleNotFound for an alias of an unknown rule, got %v", err)
+	}
+	if err := r.RegisterAlias("dummy", "dummy"); !errors.Is(err, contract.ErrInvalidRule) {
+		t.Fatalf("expected ErrInvalidRule for an alias shadowing a rule, got %v", err)
+	}
+	if err := r.RegisterAlias("dummy_alias", "dummy"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !r.Has("dummy_alias") || !r.IsImplicit("dummy_alias") || r.Canonical("dummy_alias") != "dummy" {
+		t.Fatal("expected the alias to resolve to its rule")
+	}
+	if _, ok := r.Get("dummy_alias"); !ok {
+		t.Fatal("expected Get to resolve the alias")
+	}
+	descriptor, ok := r.Describe("dummy_alias")
+	if !ok || descriptor.Name != "dummy" || len(descriptor.Aliases) != 1 || descriptor.Aliases[0] != "dummy_alias" {
+		t.Fatalf("unexpected descriptor: %+v", descriptor)
+	}
+	if r.Count() != 1 || len(r.List()) != 1 {
+		t.Fatal("aliases must not be listed or counted as rules")
+	}
+	if clone := r.Clone(); clone.Canonical("dummy_alias") != "dummy" {
+		t.Fatal("expected the clone to keep aliases")
+	}
+
+	_ = r.Register("dummy_alias", creator)
+	if r.Canonical("dummy_alias") != "dummy_alias" || r.Count() != 2 {
+		t.Fatal("registering a rule under an alias must replace the alias")
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
 )
 
 const (
@@ -57,7 +58,7 @@ func (r *AcceptedIfRule) Validate(ctx contract.RuleContext) error {
 	fieldValue := ctx.Value()
 
 	// Check if the condition field exists
-	condVal, exists := data[r.conditionField]
+	condVal, exists := utils.GetPath(data, r.conditionField)
 	if !exists {
 		return nil // Condition not met
 	}
@@ -0,0 +1,189 @@
+package acceptance
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
+)
+
+const (
+	acceptedUnlessRuleName  = "accepted_unless"
+	acceptedWithRuleName    = "accepted_with"
+	acceptedWithoutRuleName = "accepted_without"
+	declinedUnlessRuleName  = "declined_unless"
+	declinedWithRuleName    = "declined_with"
+	declinedWithoutRuleName = "declined_without"
+
+	unlessRuleDefaultMsg  = "the :attribute must be %s unless :param0 is :param1"
+	withRuleDefaultMsg    = "the :attribute must be %s when :param0 is present"
+	withoutRuleDefaultMsg = "the :attribute must be %s when :param0 is not present"
+
+	unlessRuleMissingParamMsg = "%s rule requires at least 2 parameters"
+	fieldsRuleMissingParamMsg = "%s rule requires at least one parameter"
+
+	acceptedState = "accepted"
+	declinedState = "declined"
+)
+
+// conditionalRule requires the value to be accepted or declined whenever its condition holds.
+type conditionalRule struct {
+	common.BaseRule
+	applies func(data map[string]any) bool
+	valid   func(value any) bool
+}
+
+// NewAcceptedUnlessRule requires the value to be accepted unless another field equals one of the values.
+// Usage: accepted_unless:other,value1,value2
+func NewAcceptedUnlessRule(parameters []string) (contract.Rule, error) {
+	return newUnlessRule(acceptedUnlessRuleName, acceptedState, isAccepted, parameters)
+}
+
+// NewAcceptedWithRule requires the value to be accepted when any of the other fields is present.
+// Usage: accepted_with:field1,field2
+func NewAcceptedWithRule(parameters []string) (contract.Rule, error) {
+	return newFieldsRule(acceptedWithRuleName, acceptedState, isAccepted, anyPresent, withRuleDefaultMsg, parameters)
+}
+
+// NewAcceptedWithoutRule requires the value to be accepted when any of the other fields is missing.
+// Usage: accepted_without:field1,field2
+func NewAcceptedWithoutRule(parameters []string) (contract.Rule, error) {
+	return newFieldsRule(acceptedWithoutRuleName, acceptedState, isAccepted, anyMissing, withoutRuleDefaultMsg,
+		parameters)
+}
+
+// NewDeclinedUnlessRule requires the value to be declined unless another field equals one of the values.
+// Usage: declined_unless:other,value1,value2
+func NewDeclinedUnlessRule(parameters []string) (contract.Rule, error) {
+	return newUnlessRule(declinedUnlessRuleName, declinedState, isDeclined, parameters)
+}
+
+// NewDeclinedWithRule requires the value to be declined when any of the other fields is present.
+// Usage: declined_with:field1,field2
+func NewDeclinedWithRule(parameters []string) (contract.Rule, error) {
+	return newFieldsRule(declinedWithRuleName, declinedState, isDeclined, anyPresent, withRuleDefaultMsg, parameters)
+}
+
+// NewDeclinedWithoutRule requires the value to be declined when any of the other fields is missing.
+// Usage: declined_without:field1,field2
+func NewDeclinedWithoutRule(parameters []string) (contract.Rule, error) {
+	return newFieldsRule(declinedWithoutRuleName, declinedState, isDeclined, anyMissing, withoutRuleDefaultMsg,
+		parameters)
+}
+
+// newUnlessRule builds a rule that applies unless the field parameters[0] equals one of the other parameters
+func newUnlessRule(name, state string, valid func(any) bool, parameters []string) (contract.Rule, error) {
+	if len(parameters) < 2 {
+		return nil, fmt.Errorf(unlessRuleMissingParamMsg, name)
+	}
+
+	other, values := parameters[0], parameters[1:]
+	return &conditionalRule{
+		BaseRule: common.NewBaseRule(name, fmt.Sprintf(unlessRuleDefaultMsg, state), parameters),
+		applies: func(data map[string]any) bool {
+			otherValue, exists := utils.GetPath(data, other)
+			if !exists {
+				return true
+			}
+			actual := fmt.Sprintf("%v", otherValue)
+			for _, value := range values {
+				if actual == value {
+					return false
+				}
+			}
+			return true
+		},
+		valid: valid,
+	}, nil
+}
+
+// newFieldsRule builds a rule that applies when condition holds for the fields in parameters
+func newFieldsRule(
+	name, state string,
+	valid func(any) bool,
+	condition func(data map[string]any, fields []string) bool,
+	defaultMsg string,
+	parameters []string,
+) (contract.Rule, error) {
+	if len(parameters) == 0 {
+		return nil, fmt.Errorf(fieldsRuleMissingParamMsg, name)
+	}
+
+	return &conditionalRule{
+		BaseRule: common.NewBaseRule(name, fmt.Sprintf(defaultMsg, state), parameters),
+		applies: func(data map[string]any) bool {
+			return condition(data, parameters)
+		},
+		valid: valid,
+	}, nil
+}
+
+// Validate checks the value when the condition of the rule holds.
+func (r *conditionalRule) Validate(ctx contract.RuleContext) error {
+	if r.ShouldSkipValidation(ctx.Value()) {
+		return nil
+	}
+	if !r.applies(ctx.Data()) || r.valid(ctx.Value()) {
+		return nil
+	}
+	return errors.New(r.GetMessage())
+}
+
+func (r *conditionalRule) Name() string {
+	return r.BaseRule.Name()
+}
+
+// anyPresent reports whether any of the fields is present in data
+func anyPresent(data map[string]any, fields []string) bool {
+	for _, field := range fields {
+		if _, ok := utils.GetPath(data, field); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// anyMissing reports whether any of the fields is missing from data
+func anyMissing(data map[string]any, fields []string) bool {
+	for _, field := range fields {
+		if _, ok := utils.GetPath(data, field); !ok {
+			return true
+		}
+	}
+	return false
+}
+
+// isAccepted reports whether value is "yes", "on", "1", "true", true or 1
+func isAccepted(value any) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case string:
+		return acceptedIfAcceptedValues[strings.ToLower(strings.TrimSpace(v))]
+	case int, int8, int16, int32, int64,
+		uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%v", v) == "1"
+	}
+	return false
+}
+
+// isDeclined reports whether value is "no", "off", "0", "false", false or 0
+func isDeclined(value any) bool {
+	switch v := value.(type) {
+	case bool:
+		return !v
+	case string:
+		for _, declined := range declinedAcceptedValues {
+			if strings.EqualFold(strings.TrimSpace(v), declined) {
+				return true
+			}
+		}
+	case int, int8, int16, int32, int64,
+		uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%v", v) == "0"
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+func TestConditionalAcceptanceRules(t *testing.T) {
+	tests := []struct {
+		name       string
+		create     func([]string) (contract.Rule, error)
+		params     []string
+		value      any
+		data       map[string]any
+		shouldPass bool
+	}{
+		{"accepted_unless applies", NewAcceptedUnlessRule, []string{"role", "admin"}, "no",
+			map[string]any{"role": "user"}, false},
+		{"accepted_unless applies when other missing", NewAcceptedUnlessRule, []string{"role", "admin"}, nil,
+			map[string]any{}, false},
+		{"accepted_unless exempt", NewAcceptedUnlessRule, []string{"role", "admin", "owner"}, "no",
+			map[string]any{"role": "owner"}, true},
+		{"accepted_unless accepted", NewAcceptedUnlessRule, []string{"role", "admin"}, "yes",
+			map[string]any{"role": "user"}, true},
+		{"accepted_with applies", NewAcceptedWithRule, []string{"newsletter"}, false,
+			map[string]any{"newsletter": "weekly"}, false},
+		{"accepted_with accepted", NewAcceptedWithRule, []string{"newsletter"}, 1,
+			map[string]any{"newsletter": "weekly"}, true},
+		{"accepted_with not applicable", NewAcceptedWithRule, []string{"newsletter"}, "no",
+			map[string]any{}, true},
+		{"accepted_without applies", NewAcceptedWithoutRule, []string{"guardian"}, "off",
+			map[string]any{}, false},
+		{"accepted_without not applicable", NewAcceptedWithoutRule, []string{"guardian"}, "off",
+			map[string]any{"guardian": "Ann"}, true},
+		{"declined_unless applies", NewDeclinedUnlessRule, []string{"plan", "pro"}, "yes",
+			map[string]any{"plan": "free"}, false},
+		{"declined_unless declined", NewDeclinedUnlessRule, []string{"plan", "pro"}, " Off ",
+			map[string]any{"plan": "free"}, true},
+		{"declined_unless exempt", NewDeclinedUnlessRule, []string{"plan", "pro"}, "yes",
+			map[string]any{"plan": "pro"}, true},
+		{"declined_with applies", NewDeclinedWithRule, []string{"minor"}, true,
+			map[string]any{"minor": true}, false},
+		{"declined_with declined", NewDeclinedWithRule, []string{"minor"}, 0,
+			map[string]any{"minor": true}, true},
+		{"declined_without applies", NewDeclinedWithoutRule, []string{"consent"}, "yes",
+			map[string]any{}, false},
+		{"declined_without not applicable", NewDeclinedWithoutRule, []string{"consent"}, "yes",
+			map[string]any{"consent": "yes"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := tt.create(tt.params)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			err = rule.Validate(contract.NewValidationContext("field", tt.value, tt.params, tt.data))
+			if tt.shouldPass != (err == nil) {
+				t.Errorf("value %v: expected pass=%v, got error %v", tt.value, tt.shouldPass, err)
+			}
+		})
+	}
+}
+
+func TestConditionalAcceptanceRules_InvalidParameters(t *testing.T) {
+	for name, create := range map[string]func([]string) (contract.Rule, error){
+		"accepted_unless":  NewAcceptedUnlessRule,
+		"accepted_with":    NewAcceptedWithRule,
+		"accepted_without": NewAcceptedWithoutRule,
+		"declined_unless":  NewDeclinedUnlessRule,
+		"declined_with":    NewDeclinedWithRule,
+		"declined_without": NewDeclinedWithoutRule,
+	} {
+		if _, err := create(nil); err == nil {
+			t.Errorf("%s: expected an error without parameters", name)
+		}
+		if rule, err := create([]string{"other", "value"}); err != nil || rule.Name() != name {
+			t.Errorf("%s: unexpected rule %v, error %v", name, rule, err)
+		}
+	}
+	if _, err := NewAcceptedUnlessRule([]string{"other"}); err == nil {
+		t.Error("accepted_unless: expected an error without values")
+	}
+}
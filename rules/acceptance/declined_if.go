@@ -7,6 +7,7 @@ import (
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
 )
 
 const (
@@ -46,7 +47,7 @@ func (r *DeclinedIfRule) Validate(ctx contract.RuleContext) error {
 	data := ctx.Data()
 	val := ctx.Value()
 
-	condVal, exists := data[r.conditionField]
+	condVal, exists := utils.GetPath(data, r.conditionField)
 	if !exists {
 		return nil // No condition match, skip check
 	}
@@ -18,7 +18,7 @@ const (
 // CurrentPasswordRule checks if the provided password matches the current user’s password.
 type CurrentPasswordRule struct {
 	common.BaseRule
-	verifier contract.PasswordVerifier
+	verifier contract.ContextPasswordVerifier
 }
 
 // NewCurrentPasswordRule returns a new instance of CurrentPasswordRule.
@@ -30,6 +30,11 @@ func NewCurrentPasswordRule() (contract.Rule, error) {
 
 // SetVerifier allows manual injection of a PasswordVerifier.
 func (r *CurrentPasswordRule) SetVerifier(verifier contract.PasswordVerifier) {
+	r.verifier = contract.AdaptPasswordVerifier(verifier)
+}
+
+// SetContextVerifier allows manual injection of a context-aware PasswordVerifier.
+func (r *CurrentPasswordRule) SetContextVerifier(verifier contract.ContextPasswordVerifier) {
 	r.verifier = verifier
 }
 
@@ -53,7 +58,7 @@ func (r *CurrentPasswordRule) Validate(ctx contract.RuleContext) error {
 	}
 
 	// Verify if the provided password matches the stored password
-	match, err := verifier.Verify(val)
+	match, err := verifier.VerifyContext(ctx.Context(), val)
 	if err != nil || !match {
 		return errors.New(currentPasswordRuleDefaultMsg)
 	}
@@ -62,7 +67,7 @@ func (r *CurrentPasswordRule) Validate(ctx contract.RuleContext) error {
 }
 
 // resolveVerifier attempts to retrieve a PasswordVerifier from context or registry.
-func (r *CurrentPasswordRule) resolveVerifier(ctx contract.RuleContext) contract.PasswordVerifier {
+func (r *CurrentPasswordRule) resolveVerifier(ctx contract.RuleContext) contract.ContextPasswordVerifier {
 	// Use the injected PasswordVerifier if available
 	if r.verifier != nil {
 		return r.verifier
@@ -72,11 +77,13 @@ func (r *CurrentPasswordRule) resolveVerifier(ctx contract.RuleContext) contract
 	if vCtx, ok := ctx.(interface {
 		PasswordVerifier() contract.PasswordVerifier
 	}); ok {
-		return vCtx.PasswordVerifier()
+		if v := vCtx.PasswordVerifier(); v != nil {
+			return contract.AdaptPasswordVerifier(v)
+		}
 	}
 
 	// Fallback to global registry if no PasswordVerifier is provided or found in context
-	if v, ok := password.FindPasswordVerifier("default"); ok {
+	if v, ok := password.FindContextPasswordVerifier("default"); ok {
 		return v
 	}
 
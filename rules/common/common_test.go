@@ -1,6 +1,7 @@
 package common
 
 import (
+	"context"
 	"testing"
 
 	"github.com/next-trace/scg-validator/contract"
@@ -18,6 +19,7 @@ func (f fakeCtx) Value() any                    { return f.value }
 func (f fakeCtx) Parameters() []string          { return f.params }
 func (f fakeCtx) Data() map[string]any          { return f.data }
 func (f fakeCtx) Attribute(field string) string { return "attr:" + field }
+func (f fakeCtx) Context() context.Context      { return context.Background() }
 
 func TestBaseRuleConfigAndSkip(t *testing.T) {
 	r := NewBaseRule("required", "msg", []string{"p1", "p2"}, WithNullable(true), WithMessage("m"), WithStopOnFail(true))
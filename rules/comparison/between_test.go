@@ -1,6 +1,7 @@
 package comparison_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/next-trace/scg-validator/contract"
@@ -59,8 +60,8 @@ func TestBetweenRule_InvalidParameters(t *testing.T) {
 	}{
 		{"no parameters", []string{}},
 		{"one parameter", []string{"5"}},
-		{"invalid min", []string{"abc", "10"}},
-		{"invalid max", []string{"5", "abc"}},
+		{"empty min", []string{"", "10"}},
+		{"empty max", []string{"5", ""}},
 	}
 
 	for _, tt := range tests {
@@ -72,3 +73,36 @@ func TestBetweenRule_InvalidParameters(t *testing.T) {
 		})
 	}
 }
+
+func TestBetweenRule_ExactValues(t *testing.T) {
+	rule, err := comparison.NewBetweenRule([]string{"0.01", "9007199254740992.99"})
+	if err != nil {
+		t.Fatalf("Failed to create BetweenRule: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		value      any
+		shouldPass bool
+	}{
+		{"json number at min", json.Number("0.01"), true},
+		{"json number below min", json.Number("0.009"), false},
+		{"int64 above 2^53 within max", int64(9007199254740992), true},
+		{"int64 above max", int64(9007199254740993), false},
+		{"float within range", 12.5, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := contract.NewValidationContext("amount", tt.value, []string{}, nil)
+			err := rule.Validate(ctx)
+
+			if tt.shouldPass && err != nil {
+				t.Errorf("[%s] Expected validation to pass but got error: %v", tt.name, err)
+			}
+			if !tt.shouldPass && err == nil {
+				t.Errorf("[%s] Expected validation to fail but passed", tt.name)
+			}
+		})
+	}
+}
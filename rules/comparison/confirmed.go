@@ -6,6 +6,7 @@ import (
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
 )
 
 const (
@@ -42,7 +43,7 @@ func (r *ConfirmedRule) Validate(ctx contract.RuleContext) error {
 
 	// Create the confirmation field name by appending "_confirmation" to the original field name
 	confirmationField := ctx.Field() + "_confirmation"
-	confirmationValue, exists := data[confirmationField]
+	confirmationValue, exists := utils.GetPath(data, confirmationField)
 	if !exists {
 		return errors.New(confirmedRuleConfirmationFieldMissedMsg)
 	}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
 )
 
 const (
@@ -39,7 +40,7 @@ func (r *DifferentRule) Validate(ctx contract.RuleContext) error {
 	}
 
 	// Retrieve the value of the other field to compare against
-	otherVal, ok := ctx.Data()[r.otherField]
+	otherVal, ok := utils.GetPath(ctx.Data(), r.otherField)
 	if !ok {
 		return errors.New(differentRuleParamError)
 	}
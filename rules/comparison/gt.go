@@ -3,7 +3,6 @@ package comparison
 import (
 	"errors"
 	"fmt"
-	"strconv"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
@@ -15,19 +14,20 @@ const (
 	gtRuleTypeErrorMsg = "the :attribute must have a numeric value"
 )
 
-// GtRule validates that a numeric value is greater than a specified threshold.
+// GtRule validates that a value is greater than a specified threshold or than another field.
 type GtRule struct {
 	common.BaseRule
-	threshold float64
+	threshold operand
 }
 
-// NewGtRule creates a new GtRule with a comparison threshold.
+// NewGtRule creates a new GtRule with a comparison threshold, which is a number or the path of
+// another field.
 func NewGtRule(parameters []string) (contract.Rule, error) {
 	if len(parameters) == 0 {
 		return nil, errors.New("gt rule requires a value parameter")
 	}
 
-	val, err := strconv.ParseFloat(parameters[0], 64)
+	val, err := parseOperand(parameters[0])
 	if err != nil {
 		return nil, fmt.Errorf("invalid value parameter for gt rule: %w", err)
 	}
@@ -45,18 +45,12 @@ func (r *GtRule) Validate(ctx contract.RuleContext) error {
 		return nil
 	}
 
-	// Convert value to float64
-	value, err := getAsFloat(ctx.Value())
-	if err != nil {
-		return errors.New(gtRuleTypeErrorMsg)
-	}
-
-	// Compare the value with the threshold
-	if value <= r.threshold {
-		return errors.New(gtRuleDefaultMsg)
+	// Measure the value and the threshold, then compare them
+	value, bounds, err := compareOperands(ctx, gtRuleName, gtRuleTypeErrorMsg, r.threshold)
+	if err != nil || value.cmp(bounds[0]) > 0 {
+		return err
 	}
-
-	return nil
+	return comparisonReason(gtRuleName, gtRuleDefaultMsg, value.kind, map[string]measured{"value": bounds[0]})
 }
 
 func (r *GtRule) Name() string {
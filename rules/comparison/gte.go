@@ -3,35 +3,33 @@ package comparison
 import (
 	"errors"
 	"fmt"
-	"strconv"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
 )
 
 const (
-	gteRuleName                 = "gte"
-	gteRuleDefaultMessage       = "the :attribute must be greater than or equal to :value"
-	gteRuleMissingParamError    = "gte rule requires a value parameter"
-	gteRuleInvalidParamError    = "invalid value parameter for gte rule: %w"
-	gteRuleInvalidInputType     = "the :attribute must be a numeric value"
-	gteRuleValidationFailedFmt  = "the :attribute must be greater than or equal to %s"
-	gteRuleFloatFormatPrecision = "%.6f"
+	gteRuleName              = "gte"
+	gteRuleDefaultMessage    = "the :attribute must be greater than or equal to :value"
+	gteRuleMissingParamError = "gte rule requires a value parameter"
+	gteRuleInvalidParamError = "invalid value parameter for gte rule: %w"
+	gteRuleInvalidInputType  = "the :attribute must be a numeric value"
 )
 
-// GteRule validates that the input is >= to the given comparison value.
+// GteRule validates that the input is >= to the given comparison value or another field.
 type GteRule struct {
 	common.BaseRule
-	comparisonValue float64
+	comparisonValue operand
 }
 
-// NewGteRule initializes a new GteRule from parameters.
+// NewGteRule initializes a new GteRule from parameters. The comparison value is a number or the
+// path of another field.
 func NewGteRule(parameters []string) (contract.Rule, error) {
 	if len(parameters) == 0 {
 		return nil, errors.New(gteRuleMissingParamError)
 	}
 
-	val, err := strconv.ParseFloat(parameters[0], 64)
+	val, err := parseOperand(parameters[0])
 	if err != nil {
 		return nil, fmt.Errorf(gteRuleInvalidParamError, err)
 	}
@@ -44,16 +42,11 @@ func NewGteRule(parameters []string) (contract.Rule, error) {
 
 // Validate checks if the input value is greater than or equal to the comparison value.
 func (r *GteRule) Validate(ctx contract.RuleContext) error {
-	value, err := getAsComparable(ctx.Value())
-	if err != nil {
-		return errors.New(gteRuleInvalidInputType)
-	}
-
-	if value >= r.comparisonValue {
-		return nil
+	value, bounds, err := compareOperands(ctx, gteRuleName, gteRuleInvalidInputType, r.comparisonValue)
+	if err != nil || value.cmp(bounds[0]) >= 0 {
+		return err
 	}
-
-	return fmt.Errorf(gteRuleValidationFailedFmt, floatToString(r.comparisonValue))
+	return comparisonReason(gteRuleName, gteRuleDefaultMessage, value.kind, map[string]measured{"value": bounds[0]})
 }
 
 func (r *GteRule) Name() string {
@@ -1,6 +1,7 @@
 package comparison_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/next-trace/scg-validator/rules/comparison"
@@ -24,13 +25,15 @@ func TestGteRule(t *testing.T) {
 		{"int equal to threshold", 10, true},
 		{"float greater than threshold", 10.5, true},
 		{"float equal to threshold", 10.0, true},
-		{"string numeric > threshold", "11", true},
-		{"string numeric = threshold", "10", true},
+		{"string numeric > threshold", json.Number("11"), true},
+		{"string numeric = threshold", json.Number("10"), true},
+		{"string length = threshold", "2024-01-01", true},
 
 		// Invalid values
 		{"int less than threshold", 9, false},
 		{"float less than threshold", 9.99, false},
-		{"string numeric < threshold", "9", false},
+		{"string numeric < threshold", json.Number("9"), false},
+		{"short string", "12345", false},
 		{"non-numeric string (length >= threshold)", "not a number", true}, // 13 characters >= 10
 		{"nil value", nil, false},
 		{"empty string", "", false},
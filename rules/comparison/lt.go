@@ -3,11 +3,9 @@ package comparison
 import (
 	"errors"
 	"fmt"
-	"strconv"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
-	"github.com/next-trace/scg-validator/utils"
 )
 
 const (
@@ -16,20 +14,21 @@ const (
 	ltRuleTypeErrorMsg = "the :attribute must have a numeric value"
 )
 
-// LtRule validates that a numeric value is less than the specified comparison value.
+// LtRule validates that a value is less than the specified comparison value or another field.
 type LtRule struct {
 	common.BaseRule
-	comparisonValue float64
+	comparisonValue operand
 }
 
-// NewLtRule creates a new instance of LtRule with a comparison threshold.
+// NewLtRule creates a new instance of LtRule with a comparison threshold, which is a number or
+// the path of another field.
 func NewLtRule(parameters []string) (contract.Rule, error) {
 	if len(parameters) == 0 {
 		return nil, errors.New("lt rule requires a value parameter")
 	}
 
-	// Parse the comparison value to float
-	val, err := strconv.ParseFloat(parameters[0], 64)
+	// Parse the comparison value exactly, or keep it as a field path
+	val, err := parseOperand(parameters[0])
 	if err != nil {
 		return nil, fmt.Errorf("invalid value parameter for lt rule: %w", err)
 	}
@@ -47,19 +46,12 @@ func (r *LtRule) Validate(ctx contract.RuleContext) error {
 		return nil
 	}
 
-	// Convert the value to a float64
-	value, err := utils.GetAsComparable(ctx.Value())
-	if err != nil {
-		return errors.New(ltRuleTypeErrorMsg)
+	// Measure the value and the comparison value, then compare them
+	value, bounds, err := compareOperands(ctx, ltRuleName, ltRuleTypeErrorMsg, r.comparisonValue)
+	if err != nil || value.cmp(bounds[0]) < 0 {
+		return err
 	}
-
-	// Check if the value is less than the comparison threshold
-	if value < r.comparisonValue {
-		return nil
-	}
-
-	// Return an error if the value is not less than the comparison value
-	return errors.New(ltRuleDefaultMsg)
+	return comparisonReason(ltRuleName, ltRuleDefaultMsg, value.kind, map[string]measured{"value": bounds[0]})
 }
 
 func (r *LtRule) Name() string {
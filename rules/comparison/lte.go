@@ -3,7 +3,6 @@ package comparison
 import (
 	"errors"
 	"fmt"
-	"strconv"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
@@ -15,13 +14,12 @@ const (
 	lteRuleErrMissingParam     = "lte rule requires a value parameter"
 	lteRuleErrInvalidParam     = "invalid value parameter for lte rule: %w"
 	lteRuleErrInvalidInputType = "the :attribute must be a numeric value"
-	lteRuleErrFailed           = "the :attribute must be less than or equal to %s"
 )
 
-// LteRule checks if a numeric value is <= comparisonValue.
+// LteRule checks if a value is <= comparisonValue, which is a number or another field.
 type LteRule struct {
 	common.BaseRule
-	comparisonValue float64
+	comparisonValue operand
 }
 
 // NewLteRule constructs a new lteRule instance.
@@ -30,7 +28,7 @@ func NewLteRule(parameters []string) (contract.Rule, error) {
 		return nil, errors.New(lteRuleErrMissingParam)
 	}
 
-	val, err := strconv.ParseFloat(parameters[0], 64)
+	val, err := parseOperand(parameters[0])
 	if err != nil {
 		return nil, fmt.Errorf(lteRuleErrInvalidParam, err)
 	}
@@ -43,16 +41,11 @@ func NewLteRule(parameters []string) (contract.Rule, error) {
 
 // Validate checks if value <= comparisonValue.
 func (r *LteRule) Validate(ctx contract.RuleContext) error {
-	value, err := getAsComparable(ctx.Value())
-	if err != nil {
-		return errors.New(lteRuleErrInvalidInputType)
-	}
-
-	if value <= r.comparisonValue {
-		return nil
+	value, bounds, err := compareOperands(ctx, lteRuleName, lteRuleErrInvalidInputType, r.comparisonValue)
+	if err != nil || value.cmp(bounds[0]) <= 0 {
+		return err
 	}
-
-	return fmt.Errorf(lteRuleErrFailed, floatToString(r.comparisonValue))
+	return comparisonReason(lteRuleName, lteRuleDefaultMessage, value.kind, map[string]measured{"value": bounds[0]})
 }
 
 func (r *LteRule) Name() string {
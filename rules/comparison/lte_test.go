@@ -1,6 +1,7 @@
 package comparison_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/next-trace/scg-validator/rules/comparison"
@@ -30,10 +31,14 @@ func TestLteRule(t *testing.T) {
 		{"float greater than threshold", 10.1, false},
 		{"float padded zero", 10.0000, true},
 
-		// String numeric
-		{"string numeric less", "9", true},
-		{"string numeric equal", "10", true},
-		{"string numeric greater", "11", false},
+		// Numeric strings of fields with a numeric rule, passed by the engine as json.Number
+		{"string numeric less", json.Number("9"), true},
+		{"string numeric equal", json.Number("10"), true},
+		{"string numeric greater", json.Number("11"), false},
+
+		// Other strings are measured by length
+		{"string length below threshold", "12345", true},
+		{"string length above threshold", "12345678901", false},
 
 		// Edge / invalid types
 		{"invalid string input", "not a number", false},
@@ -56,3 +61,36 @@ func TestLteRule(t *testing.T) {
 		})
 	}
 }
+
+func TestLteRule_ExactValues(t *testing.T) {
+	rule, err := comparison.NewLteRule([]string{"9007199254740993"})
+	if err != nil {
+		t.Fatalf("unexpected error creating lte rule: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		value any
+		want  bool
+	}{
+		{"int64 id at limit", int64(9007199254740993), true},
+		{"int64 id above limit", int64(9007199254740994), false},
+		{"uint64 id above limit", uint64(9007199254740995), false},
+		{"decimal string just above limit", json.Number("9007199254740993.000001"), false},
+		{"json number below limit", json.Number("9007199254740992.5"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := contract.NewValidationContext("id", tt.value, nil, nil)
+			err := rule.Validate(ctx)
+
+			if tt.want && err != nil {
+				t.Errorf("expected pass, got error: %v", err)
+			}
+			if !tt.want && err == nil {
+				t.Errorf("expected fail, but passed: value=%v", tt.value)
+			}
+		})
+	}
+}
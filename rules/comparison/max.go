@@ -3,10 +3,10 @@ package comparison
 import (
 	"errors"
 	"fmt"
-	"strconv"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
 )
 
 const (
@@ -18,7 +18,7 @@ const (
 // MaxRule is a validation rule that checks if a numeric value is not greater than a given value.
 type MaxRule struct {
 	common.BaseRule
-	comparisonValue float64
+	comparisonValue utils.Number
 }
 
 // NewMaxRule creates a new MaxRule with a comparison threshold.
@@ -28,7 +28,7 @@ func NewMaxRule(parameters []string) (contract.Rule, error) {
 	}
 
 	// Parse the threshold value
-	val, err := strconv.ParseFloat(parameters[0], 64)
+	val, err := utils.ParseNumber(parameters[0])
 	if err != nil {
 		return nil, fmt.Errorf("invalid value parameter for max rule: %w", err)
 	}
@@ -46,14 +46,14 @@ func (r *MaxRule) Validate(ctx contract.RuleContext) error {
 		return nil
 	}
 
-	// Convert the value to a float
-	value, err := getAsFloat(ctx.Value())
+	// Measure the value exactly
+	value, err := utils.GetAsSize(ctx.Value())
 	if err != nil {
 		return errors.New(maxRuleTypeErrorMsg)
 	}
 
 	// Check if the value is less than or equal to the maximum allowed
-	if value <= r.comparisonValue {
+	if value.Cmp(r.comparisonValue) <= 0 {
 		return nil
 	}
 
@@ -3,10 +3,10 @@ package comparison
 import (
 	"errors"
 	"fmt"
-	"strconv"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
 )
 
 const (
@@ -18,7 +18,7 @@ const (
 // MinRule validates that a numeric value is at least the specified minimum value.
 type MinRule struct {
 	common.BaseRule
-	comparisonValue float64
+	comparisonValue utils.Number
 }
 
 // NewMinRule creates a new MinRule with a comparison threshold.
@@ -28,7 +28,7 @@ func NewMinRule(parameters []string) (contract.Rule, error) {
 	}
 
 	// Parse the threshold value
-	val, err := strconv.ParseFloat(parameters[0], 64)
+	val, err := utils.ParseNumber(parameters[0])
 	if err != nil {
 		return nil, fmt.Errorf("invalid value parameter for min rule: %w", err)
 	}
@@ -46,14 +46,14 @@ func (r *MinRule) Validate(ctx contract.RuleContext) error {
 		return nil
 	}
 
-	// Convert the value to a float
-	value, err := getAsFloat(ctx.Value())
+	// Measure the value exactly
+	value, err := utils.GetAsSize(ctx.Value())
 	if err != nil {
 		return errors.New(minRuleTypeErrorMsg)
 	}
 
 	// Check if the value is greater than or equal to the minimum value
-	if value >= r.comparisonValue {
+	if value.Cmp(r.comparisonValue) >= 0 {
 		return nil
 	}
 
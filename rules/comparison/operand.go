@@ -0,0 +1,190 @@
+package comparison
+
+import (
+	"errors"
+	"time"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/utils"
+)
+
+// kindDate is the kind of date values, compared chronologically with other fields
+const kindDate = "date"
+
+// Suffixes of the failure reasons reported by comparisons with other fields. Failed comparisons
+// report the kind of the compared values instead, e.g. "gt.string" or "gt.date".
+const (
+	typeReasonSuffix    = ".type"
+	missingReasonSuffix = ".missing"
+)
+
+const (
+	operandEmptyMsg        = "parameter must be a number or a field path"
+	operandTypeMismatchMsg = "the :attribute must be of the same type as :other"
+	operandFieldMissingMsg = "the :attribute cannot be compared with :other, which is missing"
+)
+
+// dateLayouts are the layouts of date strings compared chronologically with date fields
+var dateLayouts = []string{time.RFC3339Nano, time.DateTime, time.DateOnly}
+
+// operand is a parameter of a comparison rule: a number, or the path of another field whose
+// value the value under validation is compared with
+type operand struct {
+	param  string
+	number utils.Number
+	field  bool
+}
+
+// parseOperand reads a parameter as a number, or else as the path of another field
+func parseOperand(param string) (operand, error) {
+	if param == "" {
+		return operand{}, errors.New(operandEmptyMsg)
+	}
+	if n, err := utils.ParseNumber(param); err == nil {
+		return operand{param: param, number: n}, nil
+	}
+	return operand{param: param, field: true}, nil
+}
+
+// measured is a value measured for a type-aware comparison
+type measured struct {
+	kind   string
+	number utils.Number
+	date   time.Time
+}
+
+// measure classifies a value for a comparison: numbers by value, time values chronologically,
+// strings by length and collections by count. Numeric strings are numbers only when the engine
+// passes them as json.Number, on fields with a numeric rule. It reports false for values that
+// cannot be compared.
+func measure(value any) (measured, bool) {
+	switch v := value.(type) {
+	case time.Time:
+		return measured{kind: kindDate, date: v}, true
+	case *time.Time:
+		if v == nil {
+			return measured{}, false
+		}
+		return measured{kind: kindDate, date: *v}, true
+	}
+
+	kind := contract.KindOf(value)
+	if kind != contract.KindNumeric && kind != contract.KindString && kind != contract.KindArray {
+		return measured{}, false
+	}
+	n, err := utils.GetAsSize(value)
+	if err != nil {
+		return measured{}, false
+	}
+	return measured{kind: string(kind), number: n}, true
+}
+
+// measureField measures the value of another field compared with value. A numeric string
+// compares by value with a number, as the numeric rule of the field under validation applies.
+func measureField(other any, value measured) (measured, bool) {
+	if s, ok := other.(string); ok && value.kind == string(contract.KindNumeric) {
+		if n, err := utils.ParseNumber(s); err == nil {
+			return measured{kind: value.kind, number: n}, true
+		}
+	}
+	return measure(other)
+}
+
+// asDate returns the time of a time value or of a date string
+func asDate(value any) (time.Time, bool) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, true
+	case *time.Time:
+		if v != nil {
+			return *v, true
+		}
+	case string:
+		for _, layout := range dateLayouts {
+			if t, err := time.Parse(layout, v); err == nil {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// cmp compares m with a measured value of the same kind and returns -1, 0 or +1
+func (m measured) cmp(other measured) int {
+	if m.kind == kindDate {
+		return m.date.Compare(other.date)
+	}
+	return m.number.Cmp(other.number)
+}
+
+// compareOperands measures the value under validation and the operands of rule for a type-aware
+// comparison. Values compared only with fields holding dates compare chronologically when they
+// are dates too, date strings included. Numeric operands take the kind of the value, so that
+// they bound its length or count; values they cannot bound fail with typeErrorMsg. Missing
+// fields and fields holding a value of another type are reported as failure reasons.
+func compareOperands(
+	ctx contract.RuleContext, rule, typeErrorMsg string, operands ...operand,
+) (measured, []measured, error) {
+	others := make([]any, len(operands))
+	dates := true
+	for i, o := range operands {
+		if !o.field {
+			dates = false
+			continue
+		}
+		other, exists := utils.GetPath(ctx.Data(), o.param)
+		if !exists || other == nil {
+			return measured{}, nil, contract.FailWith(
+				contract.NewReason(rule+missingReasonSuffix, operandFieldMissingMsg, nil).WithField("other", o.param))
+		}
+		if _, ok := asDate(other); !ok {
+			dates = false
+		}
+		others[i] = other
+	}
+
+	bounds := make([]measured, len(operands))
+	if t, ok := asDate(ctx.Value()); ok && dates {
+		for i, other := range others {
+			bound, _ := asDate(other)
+			bounds[i] = measured{kind: kindDate, date: bound}
+		}
+		return measured{kind: kindDate, date: t}, bounds, nil
+	}
+
+	value, valueOK := measure(ctx.Value())
+	for i, o := range operands {
+		if !o.field {
+			if !valueOK || value.kind == kindDate {
+				return value, nil, errors.New(typeErrorMsg)
+			}
+			bounds[i] = measured{kind: value.kind, number: o.number}
+			continue
+		}
+		bound, ok := measureField(others[i], value)
+		if !valueOK || !ok || bound.kind != value.kind {
+			return value, nil, typeReason(rule, o.param)
+		}
+		bounds[i] = bound
+	}
+	return value, bounds, nil
+}
+
+// typeReason reports a value whose type differs from the field it is compared with, named by :other
+func typeReason(rule, param string) error {
+	return contract.FailWith(
+		contract.NewReason(rule+typeReasonSuffix, operandTypeMismatchMsg, nil).WithField("other", param))
+}
+
+// comparisonReason reports a failed comparison under the kind of the compared values, with the
+// default message of the rule. Bounds render as their measure, e.g. the length of a string
+// field, except dates, which keep the attribute name of the field they were read from.
+func comparisonReason(rule, message, kind string, bounds map[string]measured) error {
+	params := make(map[string]string, len(bounds))
+	if kind != kindDate {
+		for name, bound := range bounds {
+			params[name] = bound.number.String()
+		}
+	}
+	return contract.FailWith(contract.NewReason(rule+"."+kind, message, params))
+}
@@ -0,0 +1,181 @@
+package comparison_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/comparison"
+)
+
+func TestComparisonRules_FieldOperands(t *testing.T) {
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	data := map[string]any{
+		"min_price":  10,
+		"start_page": "12",
+		"stock":      json.Number("5"),
+		"name":       "abcd",
+		"tags":       []string{"a", "b"},
+		"start_date": start,
+		"range":      map[string]any{"low": 1.5, "high": "2.5"},
+		"nothing":    nil,
+	}
+
+	tests := []struct {
+		name   string
+		newFn  func([]string) (contract.Rule, error)
+		params []string
+		value  any
+		reason string // "" when the value passes, "-" when it fails without a reason
+	}{
+		{"gt number", comparison.NewGtRule, []string{"min_price"}, 11, ""},
+		{"gt equal number", comparison.NewGtRule, []string{"min_price"}, 10, "gt.numeric"},
+		{"gt numeric string", comparison.NewGtRule, []string{"min_price"}, json.Number("10.01"), ""},
+		{"gt string length", comparison.NewGtRule, []string{"min_price"}, "10.01", "gt.type"},
+		{"gte numeric strings", comparison.NewGteRule, []string{"start_page"}, json.Number("12"), ""},
+		{"gte numeric string below", comparison.NewGteRule, []string{"start_page"}, json.Number("9"), "gte.numeric"},
+		{"gte strings compare by length", comparison.NewGteRule, []string{"start_page"}, "9", "gte.string"},
+		{"lte json number", comparison.NewLteRule, []string{"stock"}, 5, ""},
+		{"lte above stock", comparison.NewLteRule, []string{"stock"}, int64(6), "lte.numeric"},
+		{"lt string length", comparison.NewLtRule, []string{"name"}, "abc", ""},
+		{"lt string length equal", comparison.NewLtRule, []string{"name"}, "wxyz", "lt.string"},
+		{"gt array count", comparison.NewGtRule, []string{"tags"}, []int{1, 2, 3}, ""},
+		{"gt date", comparison.NewGtRule, []string{"start_date"}, start.Add(time.Hour), ""},
+		{"gt date string", comparison.NewGtRule, []string{"start_date"}, "2024-02-29", "gt.date"},
+		{"between nested fields", comparison.NewBetweenRule, []string{"range.low", "range.high"}, json.Number("2"), ""},
+		{"between fields above", comparison.NewBetweenRule, []string{"range.low", "range.high"}, 3, "between.numeric"},
+		{"between number and field", comparison.NewBetweenRule, []string{"1", "min_price"}, 10, ""},
+		{"type mismatch", comparison.NewGtRule, []string{"name"}, 5, "gt.type"},
+		{"number compared with date", comparison.NewLtRule, []string{"start_date"}, 5, "lt.type"},
+		{"number bounding a date", comparison.NewBetweenRule, []string{"1", "start_date"}, start, "-"},
+		{"missing field", comparison.NewGtRule, []string{"max_price"}, 5, "gt.missing"},
+		{"nil field", comparison.NewLteRule, []string{"nothing"}, 5, "lte.missing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := tt.newFn(tt.params)
+			if err != nil {
+				t.Fatalf("unexpected error creating rule: %v", err)
+			}
+			err = rule.Validate(contract.NewValidationContext("field", tt.value, tt.params, data))
+
+			if tt.reason == "" {
+				if err != nil {
+					t.Fatalf("expected pass, got error: %v", err)
+				}
+				return
+			}
+			reasons := contract.ReasonsOf(err)
+			if tt.reason == "-" {
+				if err == nil || len(reasons) != 0 {
+					t.Fatalf("expected a failure without reason, got %v", err)
+				}
+				return
+			}
+			if len(reasons) != 1 || reasons[0].Key != tt.reason {
+				t.Fatalf("expected reason %q, got %v", tt.reason, err)
+			}
+		})
+	}
+}
+
+func TestComparisonRules_FieldOperandMeasures(t *testing.T) {
+	data := map[string]any{"name": "héllo", "low": "2", "high": 4}
+
+	rule, _ := comparison.NewGtRule([]string{"name"})
+	reasons := contract.ReasonsOf(rule.Validate(contract.NewValidationContext("field", "hi", nil, data)))
+	if len(reasons) != 1 || reasons[0].Params["value"] != "5" {
+		t.Fatalf("expected the length of name as :value, got %+v", reasons)
+	}
+
+	rule, _ = comparison.NewBetweenRule([]string{"low", "high"})
+	reasons = contract.ReasonsOf(rule.Validate(contract.NewValidationContext("field", 5, nil, data)))
+	if len(reasons) != 1 || reasons[0].Params["min"] != "2" || reasons[0].Params["max"] != "4" {
+		t.Fatalf("expected the bounds as :min and :max, got %+v", reasons)
+	}
+}
+
+func TestComparisonRules_EmptyOperand(t *testing.T) {
+	for name, newFn := range map[string]func([]string) (contract.Rule, error){
+		"gt": comparison.NewGtRule, "gte": comparison.NewGteRule,
+		"lt": comparison.NewLtRule, "lte": comparison.NewLteRule,
+	} {
+		if _, err := newFn([]string{""}); err == nil {
+			t.Errorf("%s: expected error for an empty parameter", name)
+		}
+	}
+}
+
+func TestComparisonRules_StringsMeasureLength(t *testing.T) {
+	tests := []struct {
+		newFn  func([]string) (contract.Rule, error)
+		params []string
+		value  string
+	}{
+		{comparison.NewGteRule, []string{"5"}, "12345"},
+		{comparison.NewLteRule, []string{"5"}, "12345"},
+		{comparison.NewBetweenRule, []string{"5", "5"}, "12345"},
+		{comparison.NewGtRule, []string{"5"}, "2024-01-01"},
+		{comparison.NewLtRule, []string{"20"}, "2024-01-01"},
+		{comparison.NewBetweenRule, []string{"1", "20"}, "2024-01-01"},
+	}
+	for _, tt := range tests {
+		rule, err := tt.newFn(tt.params)
+		if err != nil {
+			t.Fatalf("unexpected error creating rule: %v", err)
+		}
+		if err := rule.Validate(contract.NewValidationContext("field", tt.value, tt.params, nil)); err != nil {
+			t.Errorf("%s with %v: expected the length to be compared, got %v", tt.value, tt.params, err)
+		}
+	}
+}
+
+func TestComparisonRules_NumericStringsMeasureAlike(t *testing.T) {
+	data := map[string]any{"five": "5", "ten": "10"}
+
+	tests := []struct {
+		name    string
+		newFn   func([]string) (contract.Rule, error)
+		literal []string
+		fields  []string
+		value   string
+		reason  string // "" when the value passes
+	}{
+		{"gt passes", comparison.NewGtRule, []string{"5"}, []string{"five"}, "10", ""},
+		{"gt fails", comparison.NewGtRule, []string{"10"}, []string{"ten"}, "5", "gt.numeric"},
+		{"gte passes", comparison.NewGteRule, []string{"10"}, []string{"ten"}, "10", ""},
+		{"gte fails", comparison.NewGteRule, []string{"10"}, []string{"ten"}, "9", "gte.numeric"},
+		{"lt passes", comparison.NewLtRule, []string{"10"}, []string{"ten"}, "5", ""},
+		{"lt fails", comparison.NewLtRule, []string{"5"}, []string{"five"}, "10", "lt.numeric"},
+		{"lte passes", comparison.NewLteRule, []string{"5"}, []string{"five"}, "5", ""},
+		{"lte fails", comparison.NewLteRule, []string{"5"}, []string{"five"}, "10", "lte.numeric"},
+		{"between passes", comparison.NewBetweenRule, []string{"5", "10"}, []string{"five", "ten"}, "7", ""},
+		{"between fails", comparison.NewBetweenRule, []string{"5", "10"}, []string{"five", "ten"},
+			"11", "between.numeric"},
+	}
+
+	for _, tt := range tests {
+		for kind, params := range map[string][]string{"literal": tt.literal, "field": tt.fields} {
+			t.Run(tt.name+" with "+kind, func(t *testing.T) {
+				rule, err := tt.newFn(params)
+				if err != nil {
+					t.Fatalf("unexpected error creating rule: %v", err)
+				}
+				// The engine passes numeric strings of fields with a numeric rule as json.Number
+				value := json.Number(tt.value)
+				err = rule.Validate(contract.NewValidationContext("field", value, params, data))
+				if tt.reason == "" {
+					if err != nil {
+						t.Fatalf("expected pass, got error: %v", err)
+					}
+					return
+				}
+				if reasons := contract.ReasonsOf(err); len(reasons) != 1 || reasons[0].Key != tt.reason {
+					t.Fatalf("expected reason %q, got %v", tt.reason, err)
+				}
+			})
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
 )
 
 const (
@@ -35,7 +36,7 @@ func NewSameRule(parameters []string) (contract.Rule, error) {
 
 // Validate checks if the field's value is the same as the other field's value.
 func (r *SameRule) Validate(ctx contract.RuleContext) error {
-	otherValue, ok := ctx.Data()[r.otherField]
+	otherValue, ok := utils.GetPath(ctx.Data(), r.otherField)
 	if !ok {
 		return errors.New(sameRuleFieldForCompareMissedMsg)
 	}
@@ -3,10 +3,10 @@ package comparison
 import (
 	"errors"
 	"fmt"
-	"strconv"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
 )
 
 const (
@@ -18,7 +18,7 @@ const (
 // SizeRule is a validation rule that checks if a value's size matches a given value.
 type SizeRule struct {
 	common.BaseRule
-	size float64
+	size utils.Number
 }
 
 // NewSizeRule creates a new SizeRule.
@@ -28,7 +28,7 @@ func NewSizeRule(parameters []string) (contract.Rule, error) {
 	}
 
 	// Parse the provided size parameter
-	val, err := strconv.ParseFloat(parameters[0], 64)
+	val, err := utils.ParseNumber(parameters[0])
 	if err != nil {
 		return nil, fmt.Errorf("size rule parameter must be numeric: %v", err)
 	}
@@ -46,14 +46,14 @@ func (r *SizeRule) Validate(ctx contract.RuleContext) error {
 		return nil
 	}
 
-	// Get the actual value as a float
-	actualValue, err := getAsFloat(ctx.Value())
+	// Measure the actual value exactly
+	actualValue, err := utils.GetAsSize(ctx.Value())
 	if err != nil {
 		return errors.New(sizeRuleParamError)
 	}
 
 	// Check if the size matches the specified size
-	if actualValue != r.size {
+	if actualValue.Cmp(r.size) != 0 {
 		return errors.New(sizeRuleDefaultMsg)
 	}
 
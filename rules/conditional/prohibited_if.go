@@ -6,6 +6,7 @@ import (
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
 )
 
 const (
@@ -42,12 +43,12 @@ func (r *prohibitedIfRule) Validate(ctx contract.RuleContext) error {
 	data := ctx.Data()
 	field := ctx.Field()
 
-	otherValue, ok := data[r.otherField]
+	otherValue, ok := utils.GetPath(data, r.otherField)
 	if !ok || fmt.Sprintf("%v", otherValue) != r.value {
 		return nil // Other field is not present or doesn't match → pass
 	}
 
-	if _, present := data[field]; present {
+	if _, present := utils.GetPath(data, field); present {
 		return errors.New(prohibitedIfRuleDefaultMsg)
 	}
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
 )
 
 const (
@@ -43,13 +44,13 @@ func (r *prohibitedUnlessRule) Validate(ctx contract.RuleContext) error {
 	data := ctx.Data()
 	field := ctx.Field()
 
-	otherValue, ok := data[r.otherField]
+	otherValue, ok := utils.GetPath(data, r.otherField)
 
 	if ok && fmt.Sprintf("%v", otherValue) == r.value {
 		return nil // allowed: other field has allowed value
 	}
 
-	if _, present := data[field]; present {
+	if _, present := utils.GetPath(data, field); present {
 		return errors.New(prohibitedUnlessRuleDefaultMsg)
 	}
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
 )
 
 const (
@@ -41,13 +42,13 @@ func (r *prohibitsRule) Validate(ctx contract.RuleContext) error {
 	field := ctx.Field()
 
 	// If the current field is absent, rule passes
-	if _, exists := data[field]; !exists {
+	if _, exists := utils.GetPath(data, field); !exists {
 		return nil
 	}
 
 	// Current field is present → check that none of the prohibited fields are present
 	for _, other := range r.otherFields {
-		if _, conflict := data[other]; conflict {
+		if _, conflict := utils.GetPath(data, other); conflict {
 			return errors.New(prohibitsRuleDefaultMsg)
 		}
 	}
@@ -97,3 +97,16 @@ func TestRequiredIfRule_InvalidParameters(t *testing.T) {
 		})
 	}
 }
+
+func TestRequiredIfRule_NestedConditionField(t *testing.T) {
+	rule, err := conditional.NewRequiredIfRule([]string{"order.status", "active"})
+	if err != nil {
+		t.Fatalf("Failed to create RequiredIfRule: %v", err)
+	}
+
+	data := map[string]any{"order": map[string]any{"status": "active"}}
+	ctx := contract.NewValidationContext("field", "", nil, data)
+	if err := rule.Validate(ctx); err == nil {
+		t.Error("expected failure when nested condition is met and field is empty")
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
 )
 
 const (
@@ -40,7 +41,7 @@ func (r *requiredUnlessRule) Name() string {
 
 // Validate checks if the field is required unless the other field has the specified value.
 func (r *requiredUnlessRule) Validate(ctx contract.RuleContext) error {
-	otherValue, exists := ctx.Data()[r.conditionField]
+	otherValue, exists := utils.GetPath(ctx.Data(), r.conditionField)
 	if exists && fmt.Sprintf("%v", otherValue) == r.conditionValue {
 		return nil // condition met, field not required
 	}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
 )
 
 const (
@@ -39,7 +40,7 @@ func (r *requiredWithAllRule) Name() string {
 func (r *requiredWithAllRule) Validate(ctx contract.RuleContext) error {
 	data := ctx.Data()
 	for _, field := range r.otherFields {
-		if _, ok := data[field]; !ok {
+		if _, ok := utils.GetPath(data, field); !ok {
 			return nil // If any required field is missing, this rule passes
 		}
 	}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
 )
 
 const (
@@ -41,7 +42,7 @@ func (r *requiredWithoutRule) Validate(ctx contract.RuleContext) error {
 	data := ctx.Data()
 	anyFieldPresent := false
 	for _, field := range r.otherFields {
-		if _, ok := data[field]; ok {
+		if _, ok := utils.GetPath(data, field); ok {
 			anyFieldPresent = true
 			break
 		}
@@ -0,0 +1,170 @@
+package rules
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+// contractRuleNames parses contract/rule.go and returns the Name of every ValidationRule it declares
+func contractRuleNames(t *testing.T) []string {
+	t.Helper()
+
+	file, err := parser.ParseFile(token.NewFileSet(), "../contract/rule.go", nil, 0)
+	if err != nil {
+		t.Fatalf("parse contract/rule.go: %v", err)
+	}
+
+	var names []string
+	ast.Inspect(file, func(node ast.Node) bool {
+		lit, ok := node.(*ast.CompositeLit)
+		if !ok {
+			return true
+		}
+		if typ, ok := lit.Type.(*ast.Ident); !ok || typ.Name != "ValidationRule" {
+			return true
+		}
+		for _, elt := range lit.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			if key, ok := kv.Key.(*ast.Ident); !ok || key.Name != "Name" {
+				continue
+			}
+			if value, ok := kv.Value.(*ast.BasicLit); ok && value.Kind == token.STRING {
+				name, _ := strconv.Unquote(value.Value)
+				names = append(names, name)
+			}
+		}
+		return false
+	})
+	return names
+}
+
+func TestContractRulesAreRegistered(t *testing.T) {
+	names := contractRuleNames(t)
+	if len(names) == 0 {
+		t.Fatal("expected rule declarations in contract/rule.go")
+	}
+
+	reg := NewRuleRegistry()
+	for _, name := range names {
+		creator, ok := reg.Get(name)
+		if !ok {
+			t.Errorf("rule %s declared in contract/rule.go is not registered", name)
+			continue
+		}
+		if _, ok := reg.Describe(name); !ok {
+			t.Errorf("rule %s has no descriptor", name)
+		}
+		if creator == nil {
+			t.Errorf("rule %s has no creator", name)
+		}
+	}
+}
+
+func TestNewRuleRegistry_Aliases(t *testing.T) {
+	reg := NewRuleRegistry()
+	for alias, name := range defaultAliases {
+		if got := reg.Canonical(alias); got != name {
+			t.Errorf("expected %s to resolve to %s, got %s", alias, name, got)
+		}
+	}
+	if descriptor, ok := reg.Describe(RuleAlphaNum); !ok || len(descriptor.Aliases) != 1 ||
+		descriptor.Aliases[0] != RuleAlphaNumAlias {
+		t.Fatalf("expected alpha_num to list its alias, got %+v", descriptor)
+	}
+
+	// Aliases of excluded rules are not registered
+	if reg := NewRuleRegistry(WithExcludeRules(RuleExists)); reg.Has(RuleExistAlias) {
+		t.Fatal("did not expect the alias of an excluded rule")
+	}
+}
+
+// exampleParams are valid parameters of each parameter type
+var exampleParams = map[contract.ParamType]string{
+	contract.ParamString:  "a",
+	contract.ParamNumber:  "1",
+	contract.ParamInteger: "1",
+	contract.ParamField:   "other",
+	contract.ParamDate:    "2024-01-01",
+	contract.ParamPattern: "/^a$/",
+	contract.ParamOperand: "1",
+}
+
+// exampleParamsByName override exampleParams for parameters whose format depends on the rule
+var exampleParamsByName = map[string]string{
+	"zone": "UTC",
+}
+
+// exampleParam returns a valid example value of a parameter
+func exampleParam(spec contract.ParamSpec) string {
+	if value, ok := exampleParamsByName[spec.Name]; ok {
+		return value
+	}
+	return exampleParams[spec.Type]
+}
+
+// exampleParamLists returns parameter lists of every count around the arity of descriptor, from
+// one too few to one more than the maximum, and the same lists with a numeric parameter that is
+// not a number
+func exampleParamLists(descriptor contract.RuleDescriptor) [][]string {
+	minParams, maxParams := descriptor.Arity()
+	upper := maxParams + 1
+	if maxParams < 0 {
+		upper = minParams + 2
+	}
+
+	var lists [][]string
+	for count := max(minParams-1, 0); count <= upper; count++ {
+		params := make([]string, count)
+		for i := range params {
+			params[i] = exampleParam(paramSpecAt(descriptor, i))
+		}
+		lists = append(lists, params)
+
+		for i := range params {
+			if spec := paramSpecAt(descriptor, i); spec.Type == contract.ParamNumber || spec.Type == contract.ParamInteger {
+				invalid := append([]string(nil), params...)
+				invalid[i] = "x"
+				lists = append(lists, invalid)
+			}
+		}
+	}
+	return lists
+}
+
+// paramSpecAt returns the spec of the parameter at index i, repeating a variadic last parameter
+// and describing surplus parameters as strings
+func paramSpecAt(descriptor contract.RuleDescriptor, i int) contract.ParamSpec {
+	if i < len(descriptor.Params) {
+		return descriptor.Params[i]
+	}
+	if n := len(descriptor.Params); n > 0 && descriptor.Params[n-1].Variadic {
+		return descriptor.Params[n-1]
+	}
+	return contract.ParamSpec{Name: "extra", Type: contract.ParamString}
+}
+
+func TestDescriptorsAgreeWithCreators(t *testing.T) {
+	reg := NewRuleRegistry()
+	for _, descriptor := range reg.Descriptors() {
+		creator, ok := reg.Get(descriptor.Name)
+		if !ok {
+			t.Errorf("rule %s is described but not registered", descriptor.Name)
+			continue
+		}
+		for _, params := range exampleParamLists(descriptor) {
+			checkErr := descriptor.CheckParams(params)
+			_, createErr := creator(params)
+			if (checkErr == nil) != (createErr == nil) {
+				t.Errorf("%s%v: CheckParams error %v, creator error %v", descriptor.Name, params, checkErr, createErr)
+			}
+		}
+	}
+}
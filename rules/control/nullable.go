@@ -26,7 +26,7 @@ func (r *nullableRule) Name() string {
 	return nullableRuleName
 }
 
-// Validate always passes — indicates the field is allowed to be nil or missing.
+// Validate always passes; the engine skips non-implicit rules when the value is nil.
 func (r *nullableRule) Validate(_ contract.RuleContext) error {
 	return nil
 }
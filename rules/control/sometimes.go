@@ -26,7 +26,7 @@ func (r *sometimesRule) Name() string {
 	return sometimesRuleName
 }
 
-// Validate is a no-op; it's handled by the validation engine.
+// Validate is a no-op; the engine skips the field when it is missing from the input.
 func (r *sometimesRule) Validate(_ contract.RuleContext) error {
 	return nil
 }
@@ -1,8 +1,12 @@
 package database
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/registry/database"
@@ -10,29 +14,47 @@ import (
 )
 
 const (
-	existRuleName              = "exist"
-	existRuleDefaultMsg        = "exist rule requires table and field parameters: exist:table,field"
+	existRuleName              = "exists"
+	existRuleDefaultMsg        = "exists rule requires a table parameter: exists:table[,column]"
 	existRuleNotImplementedMsg = "the presence verifier for table '%s' is not implemented; " +
 		"please provide a '%s'PresenceVerifier"
-	existRuleMissingTableMsg = "exist rule requires a table name parameter"
-	existRuleFailedMsg       = "%v does not exist in %s.%s"
+	existRuleParamsMsg = "exists rule takes a table and an optional column: exists:table[,column]"
+	existRuleFailedMsg = "%v does not exist in %s.%s"
 )
 
+// ExistReasonMissing is the failure reason reported for each value that does not exist. Its
+// message can use the :value, :table and :column placeholders.
+const ExistReasonMissing = "exists.missing"
+
+// existBatchKey identifies prefetched existence results in the request context
+type existBatchKey struct {
+	table string
+	field string
+}
+
 type existRule struct {
 	common.BaseRule
 	table string
+	field string
 }
 
-// NewExistRule initializes an existRule instance.
-// Usage: exist:table,field
+// Ensure existRule can batch its lookups
+var _ contract.Prefetcher = (*existRule)(nil)
+
+// NewExistRule initializes an existRule instance. Without a column, the last segment of the
+// field path that is not an index is used, as in Laravel.
+// Usage: exists:table,field or exists:table
 func NewExistRule(params []string) (contract.Rule, error) {
-	if len(params) < 1 {
-		return nil, errors.New(existRuleMissingTableMsg)
+	if len(params) < 1 || len(params) > 2 {
+		return nil, errors.New(existRuleParamsMsg)
 	}
 
 	r := &existRule{
 		table: params[0],
 	}
+	if len(params) > 1 {
+		r.field = params[1]
+	}
 	r.BaseRule = common.NewBaseRule(existRuleName, existRuleDefaultMsg, params)
 	return r, nil
 }
@@ -41,28 +63,110 @@ func (r *existRule) Name() string {
 	return existRuleName
 }
 
+// PrefetchKey groups exist rules checking the same table and column
+func (r *existRule) PrefetchKey() string {
+	return existRuleName + ":" + r.table + "," + r.field
+}
+
+// Prefetch checks all values with one call when the table's verifier supports batching. Rules
+// without a column depend on the field and are not prefetched.
+func (r *existRule) Prefetch(ctx context.Context, values []any) (context.Context, error) {
+	if r.field == "" {
+		return ctx, nil
+	}
+	verifier, ok := database.FindBatchPresenceVerifier(r.table)
+	if !ok {
+		return ctx, nil
+	}
+
+	found, err := verifier.ExistsManyContext(ctx, r.table, r.field, uniqueValues(values))
+	if err != nil {
+		return ctx, err
+	}
+	return context.WithValue(ctx, existBatchKey{table: r.table, field: r.field}, found), nil
+}
+
+// Validate checks that the value, or every element of a slice value, exists. Each missing value
+// is reported as an ExistReasonMissing reason.
 func (r *existRule) Validate(ctx contract.RuleContext) error {
 	params := ctx.Parameters()
-	if len(params) < 2 {
+	if len(params) < 1 {
 		return errors.New(existRuleDefaultMsg)
 	}
 
 	table := params[0]
-	field := params[1]
+	field := columnParam(params, 1, ctx.Field())
 
-	verifier, ok := database.FindPresenceVerifier(table)
+	verifier, ok := database.FindContextPresenceVerifier(table)
 	if !ok {
 		return fmt.Errorf(existRuleNotImplementedMsg, table, table)
 	}
 
-	found, err := verifier.Exists(table, field, ctx.Value())
-	if err != nil {
-		return err
+	// Slices exist only when every element exists
+	values := []any{ctx.Value()}
+	if val := reflect.ValueOf(ctx.Value()); val.Kind() == reflect.Slice || val.Kind() == reflect.Array {
+		values = make([]any, val.Len())
+		for i := range values {
+			values[i] = val.Index(i).Interface()
+		}
+	}
+
+	prefetched, _ := ctx.Context().Value(existBatchKey{table: table, field: field}).(map[any]bool)
+	var reasons []contract.Reason
+	for _, value := range values {
+		var found bool
+		if prefetched != nil && isHashable(value) {
+			found = prefetched[value]
+		} else {
+			var err error
+			if found, err = verifier.ExistsContext(ctx.Context(), table, field, value); err != nil {
+				return err
+			}
+		}
+
+		if !found {
+			reasons = append(reasons, contract.NewReason(ExistReasonMissing,
+				fmt.Sprintf(existRuleFailedMsg, value, table, field),
+				map[string]string{"value": fmt.Sprint(value), "table": table, "column": field}))
+		}
 	}
 
-	if !found {
-		return fmt.Errorf(existRuleFailedMsg, ctx.Value(), table, field)
+	return contract.FailWith(reasons...)
+}
+
+// uniqueValues removes duplicate values, keeping the first occurrence
+func uniqueValues(values []any) []any {
+	seen := make(map[any]bool, len(values))
+	unique := make([]any, 0, len(values))
+	for _, value := range values {
+		if !isHashable(value) {
+			continue
+		}
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
 	}
+	return unique
+}
 
-	return nil
+// isHashable reports whether value can be used as a map key
+func isHashable(value any) bool {
+	t := reflect.TypeOf(value)
+	return t != nil && t.Comparable()
+}
+
+// columnParam returns the column parameter at index i, or the column named after the field: the
+// last segment of its path that is not an index, e.g. "email" for "users.0.email"
+func columnParam(params []string, i int, field string) string {
+	if i < len(params) && params[i] != "" {
+		return params[i]
+	}
+	segments := strings.Split(field, ".")
+	for j := len(segments) - 1; j >= 0; j-- {
+		if _, err := strconv.Atoi(segments[j]); err != nil {
+			return segments[j]
+		}
+	}
+	return field
 }
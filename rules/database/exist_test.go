@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/engine"
 	"github.com/next-trace/scg-validator/registry/database"
 	databaseRule "github.com/next-trace/scg-validator/rules/database"
 )
@@ -27,6 +28,17 @@ func (m *mockExistPresenceVerifier) Unique(_, _ string, _ any) (bool, error) {
 	return false, fmt.Errorf("not implemented")
 }
 
+// recordingExistVerifier records the column of the last existence check
+type recordingExistVerifier struct {
+	mockExistPresenceVerifier
+	field string
+}
+
+func (m *recordingExistVerifier) Exists(_, field string, _ any) (bool, error) {
+	m.field = field
+	return true, nil
+}
+
 func TestExistRule(t *testing.T) {
 	t.Run("should fail if no constructor parameters are passed", func(t *testing.T) {
 		_, err := databaseRule.NewExistRule([]string{})
@@ -35,8 +47,28 @@ func TestExistRule(t *testing.T) {
 		}
 	})
 
+	t.Run("should take a table and an optional column", func(t *testing.T) {
+		if _, err := databaseRule.NewExistRule([]string{testTableName, "email", "extra"}); err == nil {
+			t.Error("expected error for surplus parameters")
+		}
+		if _, err := databaseRule.NewExistRule([]string{testTableName}); err != nil {
+			t.Errorf("unexpected error without a column: %v", err)
+		}
+	})
+
+	t.Run("should default the column to the field name", func(t *testing.T) {
+		rule, _ := databaseRule.NewExistRule([]string{"default_column_users"})
+		verifier := &recordingExistVerifier{}
+		database.RegisterPresenceVerifier("default_column_users", verifier)
+
+		ctx := contract.NewValidationContext("users.0.email", "a@b.c", []string{"default_column_users"}, nil)
+		if err := rule.Validate(ctx); err != nil || verifier.field != "email" {
+			t.Errorf("expected the email column, got %q, %v", verifier.field, err)
+		}
+	})
+
 	t.Run("should fail if context parameters are less than 2", func(t *testing.T) {
-		rule, _ := databaseRule.NewExistRule([]string{testTableName})
+		rule, _ := databaseRule.NewExistRule([]string{testTableName, "email"})
 
 		ctx := contract.NewValidationContext("email", "value", []string{"only_table"}, nil)
 
@@ -46,7 +78,7 @@ func TestExistRule(t *testing.T) {
 	})
 
 	t.Run("should fail if verifier not registered", func(t *testing.T) {
-		rule, _ := databaseRule.NewExistRule([]string{testTableName})
+		rule, _ := databaseRule.NewExistRule([]string{testTableName, "email"})
 
 		ctx := contract.NewValidationContext("email", "test@example.com", []string{"unregistered_table", "email"}, nil)
 
@@ -56,7 +88,7 @@ func TestExistRule(t *testing.T) {
 	})
 
 	t.Run("should return error from verifier", func(t *testing.T) {
-		rule, _ := databaseRule.NewExistRule([]string{testTableName})
+		rule, _ := databaseRule.NewExistRule([]string{testTableName, "email"})
 		table := testTableName
 		database.RegisterPresenceVerifier(table, &mockExistPresenceVerifier{
 			existsErr: errors.New("db error"),
@@ -71,7 +103,7 @@ func TestExistRule(t *testing.T) {
 	})
 
 	t.Run("should fail if value does not exist", func(t *testing.T) {
-		rule, _ := databaseRule.NewExistRule([]string{testTableName})
+		rule, _ := databaseRule.NewExistRule([]string{testTableName, "email"})
 		table := testTableName
 		database.RegisterPresenceVerifier(table, &mockExistPresenceVerifier{
 			existsResult: false,
@@ -85,7 +117,7 @@ func TestExistRule(t *testing.T) {
 	})
 
 	t.Run("should pass if value exists", func(t *testing.T) {
-		rule, _ := databaseRule.NewExistRule([]string{testTableName})
+		rule, _ := databaseRule.NewExistRule([]string{testTableName, "email"})
 		table := testTableName
 		database.RegisterPresenceVerifier(table, &mockExistPresenceVerifier{
 			existsResult: true,
@@ -98,3 +130,112 @@ func TestExistRule(t *testing.T) {
 		}
 	})
 }
+
+type mockBatchPresenceVerifier struct {
+	existing   map[any]bool
+	batchCalls int
+	batchSizes []int
+	singleCall int
+}
+
+func (m *mockBatchPresenceVerifier) Exists(_, _ string, value any) (bool, error) {
+	m.singleCall++
+	return m.existing[value], nil
+}
+
+func (m *mockBatchPresenceVerifier) Unique(_, _ string, value any) (bool, error) {
+	return !m.existing[value], nil
+}
+
+func (m *mockBatchPresenceVerifier) ExistsMany(_, _ string, values []any) (map[any]bool, error) {
+	m.batchCalls++
+	m.batchSizes = append(m.batchSizes, len(values))
+	found := make(map[any]bool, len(values))
+	for _, value := range values {
+		found[value] = m.existing[value]
+	}
+	return found, nil
+}
+
+func TestExistRule_SliceValue(t *testing.T) {
+	verifier := &mockBatchPresenceVerifier{existing: map[any]bool{"a": true, "b": true}}
+	database.RegisterPresenceVerifier("slice_products", verifier)
+	rule, _ := databaseRule.NewExistRule([]string{"slice_products", "id"})
+
+	ctx := contract.NewValidationContext("ids", []any{"a", "b"}, []string{"slice_products", "id"}, nil)
+	if err := rule.Validate(ctx); err != nil {
+		t.Fatalf("expected all elements to exist, got %v", err)
+	}
+	ctx = contract.NewValidationContext("ids", []any{"a", "c"}, []string{"slice_products", "id"}, nil)
+	if err := rule.Validate(ctx); err == nil {
+		t.Fatal("expected error for missing element")
+	}
+}
+
+func TestExistRule_BatchedThroughEngine(t *testing.T) {
+	verifier := &mockBatchPresenceVerifier{existing: map[any]bool{"p1": true, "p2": true}}
+	database.RegisterPresenceVerifier("batch_products", verifier)
+
+	e := engine.NewEngine()
+	_ = e.RegisterRule("exist", databaseRule.NewExistRule)
+
+	data := engine.NewDataProvider(map[string]any{
+		"items":    []any{map[string]any{"id": "p1"}, map[string]any{"id": "p3"}, map[string]any{"id": "p2"}},
+		"featured": "p1",
+		"related":  []any{"p2", "p4"},
+	})
+	res := e.Execute(data, map[string]string{
+		"items.*.id": "exist:batch_products,id",
+		"featured":   "exist:batch_products,id",
+		"related":    "exist:batch_products,id",
+	})
+
+	if verifier.batchCalls != 1 || verifier.singleCall != 0 {
+		t.Fatalf("expected a single batch call, got %d batch and %d single calls",
+			verifier.batchCalls, verifier.singleCall)
+	}
+	if verifier.batchSizes[0] != 4 {
+		t.Fatalf("expected 4 distinct values in the batch, got %d", verifier.batchSizes[0])
+	}
+	for _, field := range []string{"items.1.id", "related"} {
+		if !res.HasFieldError(field) {
+			t.Errorf("expected error for %s, got %v", field, res.Errors())
+		}
+	}
+	for _, field := range []string{"items.0.id", "items.2.id", "featured"} {
+		if res.HasFieldError(field) {
+			t.Errorf("did not expect error for %s", field)
+		}
+	}
+}
+
+func TestExistRule_NonBatchVerifierStillWorks(t *testing.T) {
+	database.RegisterPresenceVerifier("plain_products", &mockExistPresenceVerifier{existsResult: true})
+
+	e := engine.NewEngine()
+	_ = e.RegisterRule("exist", databaseRule.NewExistRule)
+	res := e.Execute(engine.NewDataProvider(map[string]any{"ids": []any{"x", "y"}}), map[string]string{
+		"ids.*": "exist:plain_products,id",
+	})
+	if !res.IsValid() {
+		t.Fatalf("expected valid result, got %v", res.Errors())
+	}
+}
+
+func TestExistRule_ReportsEachMissingValue(t *testing.T) {
+	verifier := &mockBatchPresenceVerifier{existing: map[any]bool{"a": true}}
+	database.RegisterPresenceVerifier("reason_products", verifier)
+	rule, _ := databaseRule.NewExistRule([]string{"reason_products", "id"})
+
+	ctx := contract.NewValidationContext("ids", []any{"a", "b", "c"}, []string{"reason_products", "id"}, nil)
+	reasons := contract.ReasonsOf(rule.Validate(ctx))
+	if len(reasons) != 2 {
+		t.Fatalf("expected 2 reasons, got %v", reasons)
+	}
+	if reasons[0].Key != databaseRule.ExistReasonMissing || reasons[1].Params["value"] != "c" {
+		t.Errorf("unexpected reasons: %v", reasons)
+	}
+	if reasons[0].Message != "b does not exist in reason_products.id" {
+		t.Errorf("unexpected message: %q", reasons[0].Message)
+	}
+}
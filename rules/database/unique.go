@@ -3,6 +3,7 @@ package database
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/registry/database"
@@ -10,40 +11,73 @@ import (
 
 const (
 	uniqueRuleName              = "unique"
-	uniqueRuleDefaultMsg        = "unique rule requires table and field parameters: unique:table,field"
+	uniqueRuleDefaultMsg        = "unique rule requires a table parameter: unique:table[,column[,except[,idColumn]]]"
 	uniqueRuleNotImplementedMsg = "the presence verifier for table '%s' is not implemented; " +
 		"please provide a '%s'PresenceVerifier"
+	uniqueRuleExceptMsg = "the presence verifier for table '%s' cannot ignore rows; " +
+		"please implement contract.UniqueExceptVerifier"
 	uniqueRuleFailedMsg = "the %s must be unique"
+	uniqueRuleParamsMsg = "unique rule takes a table and optional column, except and idColumn parameters: " +
+		"unique:table[,column[,except[,idColumn]]]"
 )
 
+// uniqueDefaultIDColumn is the column of the row ignored through the except parameter by default
+const uniqueDefaultIDColumn = "id"
+
+// uniqueMaxParams is the number of parameters of unique:table,column,except,idColumn
+const uniqueMaxParams = 4
+
 // uniqueRule checks if a value is unique in the specified table/field.
 type uniqueRule struct{}
 
-// NewUniqueRule constructs a new instance of uniqueRule.
+// NewUniqueRule constructs a new instance of uniqueRule, which reads its parameters when validating.
 // Usage: unique:users,email
 func NewUniqueRule() (contract.Rule, error) {
 	return &uniqueRule{}, nil
 }
 
+// NewUniqueRuleWithParams constructs a uniqueRule after checking its parameters: a table, then
+// optionally the column, the value of the row to ignore (except) and the column holding it
+// (idColumn, "id" by default). Without a column the field name is used, and an except value of
+// "NULL" ignores no row, as in Laravel.
+// Usage: unique:users, unique:users,email or unique:users,email,5,user_id
+func NewUniqueRuleWithParams(params []string) (contract.Rule, error) {
+	if len(params) < 1 || len(params) > uniqueMaxParams {
+		return nil, errors.New(uniqueRuleParamsMsg)
+	}
+	return NewUniqueRule()
+}
+
 func (r *uniqueRule) Name() string {
 	return uniqueRuleName
 }
 
 func (r *uniqueRule) Validate(ctx contract.RuleContext) error {
 	params := ctx.Parameters()
-	if len(params) < 2 {
+	if len(params) < 1 {
 		return errors.New(uniqueRuleDefaultMsg)
 	}
 
 	table := params[0]
-	field := params[1]
+	field := columnParam(params, 1, ctx.Field())
 
-	verifier, ok := database.FindPresenceVerifier(table)
-	if !ok {
-		return fmt.Errorf(uniqueRuleNotImplementedMsg, table, table)
+	var (
+		isUnique bool
+		err      error
+	)
+	if except, idColumn, ok := exceptParams(params); ok {
+		verifier, found := database.FindUniqueExceptVerifier(table)
+		if !found {
+			return fmt.Errorf(uniqueRuleExceptMsg, table)
+		}
+		isUnique, err = verifier.UniqueExceptContext(ctx.Context(), table, field, ctx.Value(), except, idColumn)
+	} else {
+		verifier, found := database.FindContextPresenceVerifier(table)
+		if !found {
+			return fmt.Errorf(uniqueRuleNotImplementedMsg, table, table)
+		}
+		isUnique, err = verifier.UniqueContext(ctx.Context(), table, field, ctx.Value())
 	}
-
-	isUnique, err := verifier.Unique(table, field, ctx.Value())
 	if err != nil {
 		return err
 	}
@@ -54,3 +88,15 @@ func (r *uniqueRule) Validate(ctx contract.RuleContext) error {
 
 	return nil
 }
+
+// exceptParams returns the row to ignore and its id column, and false when no row is ignored
+func exceptParams(params []string) (except, idColumn string, ok bool) {
+	if len(params) < 3 || params[2] == "" || strings.EqualFold(params[2], "NULL") {
+		return "", "", false
+	}
+	idColumn = uniqueDefaultIDColumn
+	if len(params) > 3 && params[3] != "" {
+		idColumn = params[3]
+	}
+	return params[2], idColumn, true
+}
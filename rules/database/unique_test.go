@@ -1,6 +1,7 @@
 package database_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -24,6 +25,20 @@ func (m *mockUniquePresenceVerifier) Unique(_, _ string, _ any) (bool, error) {
 }
 
 func TestUniqueRule(t *testing.T) {
+	t.Run("checks its parameters", func(t *testing.T) {
+		for _, params := range [][]string{nil, {testTableName, "email", "5", "user_id", "extra"}} {
+			if _, err := databaseRule.NewUniqueRuleWithParams(params); err == nil {
+				t.Errorf("expected error for parameters %v", params)
+			}
+		}
+		valid := [][]string{{testTableName}, {testTableName, "email"}, {testTableName, "email", "5", "user_id"}}
+		for _, params := range valid {
+			if _, err := databaseRule.NewUniqueRuleWithParams(params); err != nil {
+				t.Errorf("unexpected error for parameters %v: %v", params, err)
+			}
+		}
+	})
+
 	t.Run("missing parameters", func(t *testing.T) {
 		rule, _ := databaseRule.NewUniqueRule()
 
@@ -87,3 +102,49 @@ func TestUniqueRule(t *testing.T) {
 		}
 	})
 }
+
+// mockUniqueExceptVerifier records the row ignored by a uniqueness check
+type mockUniqueExceptVerifier struct {
+	mockUniquePresenceVerifier
+	field    string
+	except   any
+	idColumn string
+}
+
+func (m *mockUniqueExceptVerifier) UniqueExceptContext(
+	_ context.Context, _, field string, _, except any, idColumn string,
+) (bool, error) {
+	m.field, m.except, m.idColumn = field, except, idColumn
+	return true, nil
+}
+
+func TestUniqueRule_ColumnAndExcept(t *testing.T) {
+	table := "unique_except_users"
+	verifier := &mockUniqueExceptVerifier{}
+	database.RegisterPresenceVerifier(table, verifier)
+	rule, _ := databaseRule.NewUniqueRule()
+
+	ctx := contract.NewValidationContext("users.0.email", "a@b.c", []string{table, "", "5"}, nil)
+	if err := rule.Validate(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if verifier.field != "email" || verifier.except != "5" || verifier.idColumn != "id" {
+		t.Errorf("unexpected check: %+v", verifier)
+	}
+
+	ctx = contract.NewValidationContext("email", "a@b.c", []string{table, "mail", "7", "user_id"}, nil)
+	if err := rule.Validate(ctx); err != nil || verifier.field != "mail" || verifier.idColumn != "user_id" {
+		t.Errorf("unexpected check: %+v, %v", verifier, err)
+	}
+
+	// Verifiers that cannot ignore rows fail instead of ignoring the except parameter
+	database.RegisterPresenceVerifier("unique_plain_users", &mockUniquePresenceVerifier{uniqueResult: true})
+	ctx = contract.NewValidationContext("email", "a@b.c", []string{"unique_plain_users", "email", "5"}, nil)
+	if err := rule.Validate(ctx); err == nil {
+		t.Error("expected an error for a verifier without UniqueExceptVerifier")
+	}
+	ctx = contract.NewValidationContext("email", "a@b.c", []string{"unique_plain_users", "email", "NULL"}, nil)
+	if err := rule.Validate(ctx); err != nil {
+		t.Errorf("expected NULL to ignore no row, got %v", err)
+	}
+}
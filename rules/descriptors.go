@@ -0,0 +1,341 @@
+package rules
+
+import (
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/message"
+)
+
+// Rule categories used in descriptors
+const (
+	CategoryAcceptance  = "acceptance"
+	CategoryBoolean     = "boolean"
+	CategoryComparison  = "comparison"
+	CategoryConditional = "conditional"
+	CategoryProhibited  = "prohibited"
+	CategoryControl     = "control"
+	CategoryDate        = "date"
+	CategoryNumeric     = "numeric"
+	CategoryString      = "string"
+	CategoryFormat      = "format"
+	CategoryFile        = "file"
+	CategoryAuth        = "auth"
+	CategoryInclusion   = "inclusion"
+	CategoryCollection  = "collection"
+	CategoryDatabase    = "database"
+)
+
+// sizeValueTypes are the value types measured by size rules
+var sizeValueTypes = []string{contract.ValueNumeric, contract.ValueString, contract.ValueArray, contract.ValueFile}
+
+// operandValueTypes are the value types of comparison rules, which also compare dates with other fields
+var operandValueTypes = []string{
+	contract.ValueNumeric, contract.ValueString, contract.ValueArray, contract.ValueFile, contract.ValueDate,
+}
+
+// param describes a required parameter
+func param(name string, paramType contract.ParamType) contract.ParamSpec {
+	return contract.ParamSpec{Name: name, Type: paramType}
+}
+
+// optionalParam describes a parameter that may be omitted
+func optionalParam(name string, paramType contract.ParamType) contract.ParamSpec {
+	return contract.ParamSpec{Name: name, Type: paramType, Optional: true}
+}
+
+// variadicParam describes a last parameter that takes one or more values
+func variadicParam(name string, paramType contract.ParamType) contract.ParamSpec {
+	return contract.ParamSpec{Name: name, Type: paramType, Variadic: true}
+}
+
+// placeholder returns p bound to the message placeholder :name
+func placeholder(p contract.ParamSpec, name string) contract.ParamSpec {
+	p.Placeholder = name
+	return p
+}
+
+// describe builds a descriptor without parameters
+func describe(name, category, description string, valueTypes ...string) contract.RuleDescriptor {
+	return contract.RuleDescriptor{Name: name, Category: category, Description: description, ValueTypes: valueTypes}
+}
+
+// withParams returns d with the given parameter specs
+func withParams(d contract.RuleDescriptor, params ...contract.ParamSpec) contract.RuleDescriptor {
+	d.Params = params
+	return d
+}
+
+// dependent returns d marked as reading other fields of the input
+func dependent(d contract.RuleDescriptor) contract.RuleDescriptor {
+	d.Dependent = true
+	return d
+}
+
+// defaultDescriptors describes every default rule. Implicit flags and default messages are
+// filled in by DefaultDescriptor so that they come from a single source.
+var defaultDescriptors = map[string]contract.RuleDescriptor{
+	// Acceptance rules
+	RuleAccepted: describe(RuleAccepted, CategoryAcceptance,
+		`Value must be "yes", "on", 1 or true`, contract.ValueAny),
+	RuleDeclined: describe(RuleDeclined, CategoryAcceptance,
+		`Value must be "no", "off", 0 or false`, contract.ValueAny),
+	RuleAcceptedIf: dependent(withParams(describe(RuleAcceptedIf, CategoryAcceptance,
+		"Value must be accepted when another field equals a value", contract.ValueAny),
+		param("other", contract.ParamField), param("value", contract.ParamString))),
+	RuleDeclinedIf: dependent(withParams(describe(RuleDeclinedIf, CategoryAcceptance,
+		"Value must be declined when another field equals a value", contract.ValueAny),
+		param("other", contract.ParamField), param("value", contract.ParamString))),
+	RuleAcceptedUnless: dependent(withParams(describe(RuleAcceptedUnless, CategoryAcceptance,
+		"Value must be accepted unless another field equals any of the values", contract.ValueAny),
+		param("other", contract.ParamField), variadicParam("values", contract.ParamString))),
+	RuleAcceptedWith: dependent(withParams(describe(RuleAcceptedWith, CategoryAcceptance,
+		"Value must be accepted when any of the other fields is present", contract.ValueAny),
+		placeholder(variadicParam("fields", contract.ParamField), "values"))),
+	RuleAcceptedWithout: dependent(withParams(describe(RuleAcceptedWithout, CategoryAcceptance,
+		"Value must be accepted when any of the other fields is missing", contract.ValueAny),
+		placeholder(variadicParam("fields", contract.ParamField), "values"))),
+	RuleDeclinedUnless: dependent(withParams(describe(RuleDeclinedUnless, CategoryAcceptance,
+		"Value must be declined unless another field equals any of the values", contract.ValueAny),
+		param("other", contract.ParamField), variadicParam("values", contract.ParamString))),
+	RuleDeclinedWith: dependent(withParams(describe(RuleDeclinedWith, CategoryAcceptance,
+		"Value must be declined when any of the other fields is present", contract.ValueAny),
+		placeholder(variadicParam("fields", contract.ParamField), "values"))),
+	RuleDeclinedWithout: dependent(withParams(describe(RuleDeclinedWithout, CategoryAcceptance,
+		"Value must be declined when any of the other fields is missing", contract.ValueAny),
+		placeholder(variadicParam("fields", contract.ParamField), "values"))),
+
+	// Boolean rules
+	RuleBoolean: describe(RuleBoolean, CategoryBoolean,
+		"Value must be a boolean or a boolean-like value", contract.ValueAny),
+
+	// Comparison rules
+	RuleMin: withParams(describe(RuleMin, CategoryComparison,
+		"Value, length, count or size must be at least the given minimum", sizeValueTypes...),
+		param("min", contract.ParamNumber)),
+	RuleMax: withParams(describe(RuleMax, CategoryComparison,
+		"Value, length, count or size must not exceed the given maximum", sizeValueTypes...),
+		param("max", contract.ParamNumber)),
+	RuleSize: withParams(describe(RuleSize, CategoryComparison,
+		"Value, length, count or size must equal the given size", sizeValueTypes...),
+		param("size", contract.ParamNumber)),
+	RuleBetween: dependent(withParams(describe(RuleBetween, CategoryComparison,
+		"Value, length, count, size or date must lie between min and max inclusive, given as numbers or fields",
+		operandValueTypes...),
+		param("min", contract.ParamOperand), param("max", contract.ParamOperand))),
+	RuleGt: dependent(withParams(describe(RuleGt, CategoryComparison,
+		"Value must be greater than the given number or field", operandValueTypes...),
+		param("value", contract.ParamOperand))),
+	RuleLt: dependent(withParams(describe(RuleLt, CategoryComparison,
+		"Value must be less than the given number or field", operandValueTypes...),
+		param("value", contract.ParamOperand))),
+	RuleGte: dependent(withParams(describe(RuleGte, CategoryComparison,
+		"Value must be greater than or equal to the given number or field", operandValueTypes...),
+		param("value", contract.ParamOperand))),
+	RuleLte: dependent(withParams(describe(RuleLte, CategoryComparison,
+		"Value must be less than or equal to the given number or field", operandValueTypes...),
+		param("value", contract.ParamOperand))),
+	RuleSame: dependent(withParams(describe(RuleSame, CategoryComparison,
+		"Value must match another field", contract.ValueAny),
+		param("other", contract.ParamField))),
+	RuleDifferent: dependent(withParams(describe(RuleDifferent, CategoryComparison,
+		"Value must differ from another field", contract.ValueAny),
+		param("other", contract.ParamField))),
+	RuleConfirmed: dependent(describe(RuleConfirmed, CategoryComparison,
+		"Value must match the <field>_confirmation field", contract.ValueAny)),
+
+	// Conditional rules
+	RuleRequired: describe(RuleRequired, CategoryConditional,
+		"Field must be present and not empty", contract.ValueAny),
+	RuleRequiredIf: dependent(withParams(describe(RuleRequiredIf, CategoryConditional,
+		"Field is required when another field equals any of the values", contract.ValueAny),
+		param("other", contract.ParamField), variadicParam("values", contract.ParamString))),
+	RuleRequiredUnless: dependent(withParams(describe(RuleRequiredUnless, CategoryConditional,
+		"Field is required unless another field equals any of the values", contract.ValueAny),
+		param("other", contract.ParamField), variadicParam("values", contract.ParamString))),
+	RuleRequiredWith: dependent(withParams(describe(RuleRequiredWith, CategoryConditional,
+		"Field is required when any of the other fields is present", contract.ValueAny),
+		placeholder(variadicParam("fields", contract.ParamField), "values"))),
+	RuleRequiredWithout: dependent(withParams(describe(RuleRequiredWithout, CategoryConditional,
+		"Field is required when any of the other fields is missing", contract.ValueAny),
+		placeholder(variadicParam("fields", contract.ParamField), "values"))),
+	RuleRequiredWithAll: dependent(withParams(describe(RuleRequiredWithAll, CategoryConditional,
+		"Field is required when all of the other fields are present", contract.ValueAny),
+		placeholder(variadicParam("fields", contract.ParamField), "values"))),
+	RuleRequiredWithoutAll: dependent(withParams(describe(RuleRequiredWithoutAll, CategoryConditional,
+		"Field is required when all of the other fields are missing", contract.ValueAny),
+		placeholder(variadicParam("fields", contract.ParamField), "values"))),
+
+	// Prohibited rules
+	RuleProhibited: describe(RuleProhibited, CategoryProhibited,
+		"Field must be missing or empty", contract.ValueAny),
+	RuleProhibitedIf: dependent(withParams(describe(RuleProhibitedIf, CategoryProhibited,
+		"Field must be missing or empty when another field equals any of the values", contract.ValueAny),
+		param("other", contract.ParamField), variadicParam("values", contract.ParamString))),
+	RuleProhibitedUnless: dependent(withParams(describe(RuleProhibitedUnless, CategoryProhibited,
+		"Field must be missing or empty unless another field equals any of the values", contract.ValueAny),
+		param("other", contract.ParamField), variadicParam("values", contract.ParamString))),
+	RuleProhibits: dependent(withParams(describe(RuleProhibits, CategoryProhibited,
+		"When the field is present, the other fields must be missing or empty", contract.ValueAny),
+		placeholder(variadicParam("fields", contract.ParamField), "other"))),
+
+	// Control rules
+	RuleBail: describe(RuleBail, CategoryControl,
+		"Stop validating the field after its first failure"),
+	RuleFilled: describe(RuleFilled, CategoryControl,
+		"Field must not be empty when it is present", contract.ValueAny),
+	RulePresent: describe(RulePresent, CategoryControl,
+		"Field must be present in the input, even if empty", contract.ValueAny),
+	RuleSometimes: describe(RuleSometimes, CategoryControl,
+		"Only validate the field when it is present in the input"),
+	RuleNullable: describe(RuleNullable, CategoryControl,
+		"Skip the field's other rules when its value is nil"),
+
+	// Date rules
+	RuleAfter: dependent(withParams(describe(RuleAfter, CategoryDate,
+		"Date must be after the given date, relative date or field", contract.ValueDate),
+		param("date", contract.ParamDate), optionalParam("format", contract.ParamString),
+		optionalParam("zone", contract.ParamString))),
+	RuleBefore: dependent(withParams(describe(RuleBefore, CategoryDate,
+		"Date must be before the given date, relative date or field", contract.ValueDate),
+		param("date", contract.ParamDate), optionalParam("format", contract.ParamString),
+		optionalParam("zone", contract.ParamString))),
+	RuleAfterOrEqual: dependent(withParams(describe(RuleAfterOrEqual, CategoryDate,
+		"Date must be after or equal to the given date, relative date or field", contract.ValueDate),
+		param("date", contract.ParamDate), optionalParam("format", contract.ParamString),
+		optionalParam("zone", contract.ParamString))),
+	RuleBeforeOrEqual: dependent(withParams(describe(RuleBeforeOrEqual, CategoryDate,
+		"Date must be before or equal to the given date, relative date or field", contract.ValueDate),
+		param("date", contract.ParamDate), optionalParam("format", contract.ParamString),
+		optionalParam("zone", contract.ParamString))),
+	RuleDate: withParams(describe(RuleDate, CategoryDate,
+		"Value must be a date or a time value; strings use the given formats, RFC 3339 by default, "+
+			"and numbers are Unix timestamps when the formats include U",
+		contract.ValueDate),
+		optionalParam("format", contract.ParamString), optionalParam("zone", contract.ParamString)),
+	RuleDateEquals: dependent(withParams(describe(RuleDateEquals, CategoryDate,
+		"Date must equal the given date, relative date or field", contract.ValueDate),
+		param("date", contract.ParamDate), optionalParam("format", contract.ParamString),
+		optionalParam("zone", contract.ParamString))),
+	RuleDateFormat: withParams(describe(RuleDateFormat, CategoryDate,
+		"Value must be a date string matching any of the given Go, named or PHP formats", contract.ValueDate),
+		placeholder(variadicParam("formats", contract.ParamString), "format")),
+
+	// Numeric rules
+	RuleNumeric: describe(RuleNumeric, CategoryNumeric,
+		"Value must be numeric", contract.ValueNumeric, contract.ValueString),
+	RuleInteger: describe(RuleInteger, CategoryNumeric,
+		"Value must be an integer", contract.ValueNumeric, contract.ValueString),
+	RuleDecimal: withParams(describe(RuleDecimal, CategoryNumeric,
+		"Value must have the given number of decimal places", contract.ValueNumeric, contract.ValueString),
+		param("min", contract.ParamInteger), optionalParam("max", contract.ParamInteger)),
+	RuleMultipleOf: withParams(describe(RuleMultipleOf, CategoryNumeric,
+		"Value must be a multiple of the given number", contract.ValueNumeric),
+		param("value", contract.ParamNumber)),
+
+	// String rules
+	RuleString: describe(RuleString, CategoryString,
+		"Value must be a string", contract.ValueAny),
+	RuleAlpha: describe(RuleAlpha, CategoryString,
+		"Value may only contain letters", contract.ValueString),
+	RuleAlphaNum: describe(RuleAlphaNum, CategoryString,
+		"Value may only contain letters and numbers", contract.ValueString),
+	RuleAlphaDash: describe(RuleAlphaDash, CategoryString,
+		"Value may only contain letters, numbers, dashes and underscores", contract.ValueString),
+	RuleLowercase: describe(RuleLowercase, CategoryString,
+		"Value must be lowercase", contract.ValueString),
+	RuleUppercase: describe(RuleUppercase, CategoryString,
+		"Value must be uppercase", contract.ValueString),
+	RuleASCII: describe(RuleASCII, CategoryString,
+		"Value may only contain ASCII characters", contract.ValueString),
+	RuleUlid: describe(RuleUlid, CategoryString,
+		"Value must be a valid ULID", contract.ValueString),
+	RuleSlug: describe(RuleSlug, CategoryString,
+		"Value must be a URL slug", contract.ValueString),
+	RuleDoesntStartWith: withParams(describe(RuleDoesntStartWith, CategoryString,
+		"Value must not start with any of the given prefixes", contract.ValueString),
+		placeholder(variadicParam("prefixes", contract.ParamString), "values")),
+	RuleDoesntEndWith: withParams(describe(RuleDoesntEndWith, CategoryString,
+		"Value must not end with any of the given suffixes", contract.ValueString),
+		placeholder(variadicParam("suffixes", contract.ParamString), "values")),
+	RuleStartsWith: withParams(describe(RuleStartsWith, CategoryString,
+		"Value must start with one of the given prefixes", contract.ValueString),
+		placeholder(variadicParam("prefixes", contract.ParamString), "values")),
+	RuleEndsWith: withParams(describe(RuleEndsWith, CategoryString,
+		"Value must end with one of the given suffixes", contract.ValueString),
+		placeholder(variadicParam("suffixes", contract.ParamString), "values")),
+	RulePassword: withParams(describe(RulePassword, CategoryString,
+		"Value must meet the given password requirements, e.g. min:12, mixed, numbers, symbols", contract.ValueString),
+		contract.ParamSpec{Name: "requirements", Type: contract.ParamString, Optional: true, Variadic: true}),
+
+	// Inclusion rules
+	RuleIn: withParams(describe(RuleIn, CategoryInclusion,
+		"Value must be one of the given values", contract.ValueAny),
+		variadicParam("values", contract.ParamString)),
+	RuleNotIn: withParams(describe(RuleNotIn, CategoryInclusion,
+		"Value must not be any of the given values", contract.ValueAny),
+		variadicParam("values", contract.ParamString)),
+
+	// Collection rules
+	RuleList: describe(RuleList, CategoryCollection,
+		"Value must be a slice or array", contract.ValueArray),
+	RuleMap: describe(RuleMap, CategoryCollection,
+		"Value must be a map", contract.ValueAny),
+
+	// Format rules
+	RuleEmail: describe(RuleEmail, CategoryFormat,
+		"Value must be a valid email address", contract.ValueString),
+	RuleURL: describe(RuleURL, CategoryFormat,
+		"Value must be a valid URL", contract.ValueString),
+	RuleActiveURL: describe(RuleActiveURL, CategoryFormat,
+		"Value must be a URL whose host resolves in DNS", contract.ValueString),
+	RuleUUID: describe(RuleUUID, CategoryFormat,
+		"Value must be a valid UUID", contract.ValueString),
+	RuleJSON: describe(RuleJSON, CategoryFormat,
+		"Value must be a valid JSON string", contract.ValueString),
+	RuleRegex: withParams(describe(RuleRegex, CategoryFormat,
+		"Value must match the given regular expression", contract.ValueString),
+		param("pattern", contract.ParamPattern)),
+	RuleIP: withParams(describe(RuleIP, CategoryFormat,
+		"Value must be an IPv4, IPv6 or MAC address, or one of the given type", contract.ValueString),
+		optionalParam("type", contract.ParamString)),
+	RuleIPv4: describe(RuleIPv4, CategoryFormat,
+		"Value must be an IPv4 address", contract.ValueString),
+	RuleIPv6: describe(RuleIPv6, CategoryFormat,
+		"Value must be an IPv6 address", contract.ValueString),
+	RuleMACAddress: describe(RuleMACAddress, CategoryFormat,
+		"Value must be a MAC address", contract.ValueString),
+
+	// File rules
+	RuleFile: describe(RuleFile, CategoryFile,
+		"Value must be an uploaded file", contract.ValueFile),
+	RuleImage: describe(RuleImage, CategoryFile,
+		"Value must be an uploaded image", contract.ValueFile),
+	RuleMimes: withParams(describe(RuleMimes, CategoryFile,
+		"File must have one of the given extensions", contract.ValueFile),
+		placeholder(variadicParam("extensions", contract.ParamString), "values")),
+
+	// Auth rules
+	RuleCurrentPassword: describe(RuleCurrentPassword, CategoryAuth,
+		"Value must match the current user's password", contract.ValueString),
+
+	// Database rules
+	RuleExists: withParams(describe(RuleExists, CategoryDatabase,
+		"Value, or every element of a list, must exist in the given table column, named after the field by default",
+		contract.ValueAny),
+		param("table", contract.ParamString), optionalParam("column", contract.ParamString)),
+	RuleUnique: withParams(describe(RuleUnique, CategoryDatabase,
+		"Value must not exist yet in the given table column, named after the field by default, "+
+			"ignoring the row whose idColumn holds except", contract.ValueAny),
+		param("table", contract.ParamString), optionalParam("column", contract.ParamString),
+		optionalParam("except", contract.ParamString), optionalParam("idColumn", contract.ParamString)),
+}
+
+// DefaultDescriptor returns the descriptor of a default rule
+func DefaultDescriptor(name string) (contract.RuleDescriptor, bool) {
+	descriptor, ok := defaultDescriptors[name]
+	if !ok {
+		return contract.RuleDescriptor{}, false
+	}
+	descriptor.Implicit = implicitRules[name]
+	descriptor.DefaultMessage, _ = message.DefaultMessage(name)
+	return descriptor, true
+}
@@ -16,7 +16,10 @@ const (
 	MAC  = "mac"
 	ANY  = "any" // accepts ipv4, ipv6, mac
 
-	ipRuleName = "ip"
+	ipRuleName         = "ip"
+	ipv4RuleName       = "ipv4"
+	ipv6RuleName       = "ipv6"
+	macAddressRuleName = "mac_address"
 
 	ipRuleDefaultMsg         = "the :attribute must be a valid IP address"
 	ipRuleDataNotProvidedMsg = "the :attribute must provide a valid IP address, but it is empty or not provided"
@@ -36,10 +39,30 @@ func NewIPRule(parameters []string) (contract.Rule, error) {
 	if len(parameters) > 0 {
 		ipType = strings.ToLower(parameters[0])
 	}
+	return newIPRule(ipRuleName, ipType, parameters), nil
+}
+
+// NewIPv4Rule creates an IPRule accepting only IPv4 addresses.
+func NewIPv4Rule(parameters []string) (contract.Rule, error) {
+	return newIPRule(ipv4RuleName, IPV4, parameters), nil
+}
+
+// NewIPv6Rule creates an IPRule accepting only IPv6 addresses.
+func NewIPv6Rule(parameters []string) (contract.Rule, error) {
+	return newIPRule(ipv6RuleName, IPV6, parameters), nil
+}
+
+// NewMACAddressRule creates an IPRule accepting only MAC addresses.
+func NewMACAddressRule(parameters []string) (contract.Rule, error) {
+	return newIPRule(macAddressRuleName, MAC, parameters), nil
+}
+
+// newIPRule creates an IPRule named name that checks addresses of ipType.
+func newIPRule(name, ipType string, parameters []string) *IPRule {
 	return &IPRule{
-		BaseRule: common.NewBaseRule(ipRuleName, ipRuleDefaultMsg, parameters),
+		BaseRule: common.NewBaseRule(name, ipRuleDefaultMsg, parameters),
 		ipType:   ipType,
-	}, nil
+	}
 }
 
 // Validate performs the IP/MAC format validation.
@@ -103,5 +126,5 @@ func (r *IPRule) newError(msg string) error {
 }
 
 func (r *IPRule) Name() string {
-	return ipRuleName
+	return r.BaseRule.Name()
 }
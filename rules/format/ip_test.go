@@ -64,3 +64,35 @@ func TestIPRule(t *testing.T) {
 		})
 	}
 }
+
+func TestAddressRules(t *testing.T) {
+	tests := []struct {
+		name       string
+		create     func([]string) (contract.Rule, error)
+		value      any
+		shouldPass bool
+	}{
+		{"ipv4", format.NewIPv4Rule, "10.0.0.1", true},
+		{"ipv4", format.NewIPv4Rule, "2001:db8::1", false},
+		{"ipv6", format.NewIPv6Rule, "2001:db8::1", true},
+		{"ipv6", format.NewIPv6Rule, "10.0.0.1", false},
+		{"mac_address", format.NewMACAddressRule, "01-23-45-67-89-ab", true},
+		{"mac_address", format.NewMACAddressRule, "10.0.0.1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := tt.create(nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if rule.Name() != tt.name {
+				t.Errorf("expected rule name %s, got %s", tt.name, rule.Name())
+			}
+			err = rule.Validate(contract.NewValidationContext("address", tt.value, nil, nil))
+			if tt.shouldPass != (err == nil) {
+				t.Errorf("value %v: expected pass=%v, got error %v", tt.value, tt.shouldPass, err)
+			}
+		})
+	}
+}
@@ -6,8 +6,11 @@ import (
 
 	"github.com/next-trace/scg-validator/registry/rules"
 	"github.com/next-trace/scg-validator/rules/authentication"
+	"github.com/next-trace/scg-validator/rules/database"
 	"github.com/next-trace/scg-validator/rules/file"
 	"github.com/next-trace/scg-validator/rules/format"
+	"github.com/next-trace/scg-validator/rules/inclusion"
+	"github.com/next-trace/scg-validator/rules/types/collection"
 	dateRules "github.com/next-trace/scg-validator/rules/types/date"
 	stringRules "github.com/next-trace/scg-validator/rules/types/string"
 
@@ -25,10 +28,16 @@ import (
 // grouped by category for easier understanding and management.
 const (
 	// Acceptance Rules
-	RuleAccepted   = "accepted"
-	RuleDeclined   = "declined"
-	RuleAcceptedIf = "accepted_if"
-	RuleDeclinedIf = "declined_if"
+	RuleAccepted        = "accepted"
+	RuleDeclined        = "declined"
+	RuleAcceptedIf      = "accepted_if"
+	RuleDeclinedIf      = "declined_if"
+	RuleAcceptedUnless  = "accepted_unless"
+	RuleAcceptedWith    = "accepted_with"
+	RuleAcceptedWithout = "accepted_without"
+	RuleDeclinedUnless  = "declined_unless"
+	RuleDeclinedWith    = "declined_with"
+	RuleDeclinedWithout = "declined_without"
 
 	// Boolean Rules
 	RuleBoolean = "boolean"
@@ -75,6 +84,7 @@ const (
 	RuleAfterOrEqual  = "after_or_equal"
 	RuleDate          = "date"
 	RuleDateEquals    = "date_equals"
+	RuleDateFormat    = "date_format"
 
 	// Numeric Rules
 	RuleNumeric    = "numeric"
@@ -83,19 +93,35 @@ const (
 	RuleMultipleOf = "multiple_of"
 
 	// String Rules
-	RuleAlpha     = "alpha"
-	RuleAlphaNum  = "alpha_num"
-	RuleAlphaDash = "alpha_dash"
-	RuleEmail     = "email"
-	RuleUUID      = "uuid"
-	RuleURL       = "url"
-	RuleActiveURL = "active_url"
-	RuleJSON      = "json"
-	RuleRegex     = "regex"
-	RuleIP        = "ip"
-	RuleIPv4      = "ipv4"
-	RuleIPv6      = "ipv6"
-	RuleMAC       = "mac"
+	RuleString     = "string"
+	RuleAlpha      = "alpha"
+	RuleAlphaNum   = "alpha_num"
+	RuleAlphaDash  = "alpha_dash"
+	RuleEmail      = "email"
+	RuleUUID       = "uuid"
+	RuleURL        = "url"
+	RuleActiveURL  = "active_url"
+	RuleJSON       = "json"
+	RuleRegex      = "regex"
+	RuleIP         = "ip"
+	RuleIPv4       = "ipv4"
+	RuleIPv6       = "ipv6"
+	RuleMACAddress = "mac_address"
+	RulePassword   = "password"
+	RuleStartsWith = "starts_with"
+	RuleEndsWith   = "ends_with"
+
+	// Inclusion Rules
+	RuleIn    = "in"
+	RuleNotIn = "not_in"
+
+	// Collection Rules
+	RuleList = "list"
+	RuleMap  = "map"
+
+	// Database Rules
+	RuleExists = "exists"
+	RuleUnique = "unique"
 
 	// File Validation Rules
 	RuleFile  = "file"
@@ -113,8 +139,53 @@ const (
 
 	// Auth Rules
 	RuleCurrentPassword = "current_password"
+
+	// Aliases of the rules above
+	RuleAlphaNumAlias = "alphanum"
+	RuleExistAlias    = "exist"
+	RuleMAC           = "mac"
 )
 
+// defaultAliases maps alternative rule names to the canonical name they resolve to
+var defaultAliases = map[string]string{
+	RuleAlphaNumAlias: RuleAlphaNum,
+	RuleExistAlias:    RuleExists,
+	RuleMAC:           RuleMACAddress,
+}
+
+// implicitRules lists the default rules that run even when the field is missing or empty.
+// All other rules are skipped by the engine for missing or empty values.
+var implicitRules = map[string]bool{
+	RuleAccepted:           true,
+	RuleAcceptedIf:         true,
+	RuleAcceptedUnless:     true,
+	RuleAcceptedWith:       true,
+	RuleAcceptedWithout:    true,
+	RuleDeclined:           true,
+	RuleDeclinedIf:         true,
+	RuleDeclinedUnless:     true,
+	RuleDeclinedWith:       true,
+	RuleDeclinedWithout:    true,
+	RuleRequired:           true,
+	RuleRequiredIf:         true,
+	RuleRequiredUnless:     true,
+	RuleRequiredWith:       true,
+	RuleRequiredWithout:    true,
+	RuleRequiredWithAll:    true,
+	RuleRequiredWithoutAll: true,
+	RuleProhibited:         true,
+	RuleProhibitedIf:       true,
+	RuleProhibitedUnless:   true,
+	RuleProhibits:          true,
+	RulePresent:            true,
+	RuleFilled:             true,
+}
+
+// IsImplicitRule reports whether a default rule is implicit
+func IsImplicitRule(name string) bool {
+	return implicitRules[name]
+}
+
 // WithCustomRule adds a custom rule to the registry
 func WithCustomRule(name string, creator contract.RuleCreator) rules.Option {
 	return func(config *contract.Config) {
@@ -125,6 +196,29 @@ func WithCustomRule(name string, creator contract.RuleCreator) rules.Option {
 	}
 }
 
+// WithCustomImplicitRule adds a custom rule that runs even when the field is missing or empty
+func WithCustomImplicitRule(name string, creator contract.RuleCreator) rules.Option {
+	return func(config *contract.Config) {
+		WithCustomRule(name, creator)(config)
+		if config.ImplicitRules == nil {
+			config.ImplicitRules = make(map[string]bool)
+		}
+		config.ImplicitRules[name] = true
+	}
+}
+
+// WithCustomRuleDescriptor adds a custom rule described by descriptor; descriptor.Implicit
+// decides whether it runs for missing or empty values
+func WithCustomRuleDescriptor(descriptor contract.RuleDescriptor, creator contract.RuleCreator) rules.Option {
+	return func(config *contract.Config) {
+		WithCustomRule(descriptor.Name, creator)(config)
+		if config.Descriptors == nil {
+			config.Descriptors = make(map[string]contract.RuleDescriptor)
+		}
+		config.Descriptors[descriptor.Name] = descriptor
+	}
+}
+
 // WithCustomMessage sets a custom message for a rule
 func WithCustomMessage(ruleName string, message string) rules.Option {
 	return func(config *contract.Config) {
@@ -176,6 +270,14 @@ func NewRuleRegistry(options ...rules.Option) contract.Registry {
 
 	// Register custom rules from config
 	for name, creator := range config.CustomRules {
+		if descriptor, ok := config.Descriptors[name]; ok {
+			_ = reg.RegisterDescriptor(descriptor, creator)
+			continue
+		}
+		if config.ImplicitRules[name] {
+			_ = reg.RegisterImplicit(name, creator)
+			continue
+		}
 		_ = reg.Register(name, creator)
 	}
 
@@ -186,10 +288,16 @@ func NewRuleRegistry(options ...rules.Option) contract.Registry {
 func registerDefaultRules(reg contract.Registry, config *contract.Config) error {
 	rules := map[string]contract.RuleCreator{
 		// Acceptance rules
-		RuleAccepted:   func(_ []string) (contract.Rule, error) { return acceptance.NewAcceptedRule() },
-		RuleDeclined:   func(_ []string) (contract.Rule, error) { return acceptance.NewDeclinedRule() },
-		RuleAcceptedIf: acceptance.NewAcceptedIfRule,
-		RuleDeclinedIf: acceptance.NewDeclinedIfRule,
+		RuleAccepted:        func(_ []string) (contract.Rule, error) { return acceptance.NewAcceptedRule() },
+		RuleDeclined:        func(_ []string) (contract.Rule, error) { return acceptance.NewDeclinedRule() },
+		RuleAcceptedIf:      acceptance.NewAcceptedIfRule,
+		RuleDeclinedIf:      acceptance.NewDeclinedIfRule,
+		RuleAcceptedUnless:  acceptance.NewAcceptedUnlessRule,
+		RuleAcceptedWith:    acceptance.NewAcceptedWithRule,
+		RuleAcceptedWithout: acceptance.NewAcceptedWithoutRule,
+		RuleDeclinedUnless:  acceptance.NewDeclinedUnlessRule,
+		RuleDeclinedWith:    acceptance.NewDeclinedWithRule,
+		RuleDeclinedWithout: acceptance.NewDeclinedWithoutRule,
 
 		// Boolean rules
 		RuleBoolean: func(_ []string) (contract.Rule, error) { return boolean.NewBooleanRule() },
@@ -234,6 +342,9 @@ func registerDefaultRules(reg contract.Registry, config *contract.Config) error
 		RuleBefore:        dateRules.NewBeforeRule,
 		RuleBeforeOrEqual: dateRules.NewBeforeOrEqualRule,
 		RuleAfterOrEqual:  dateRules.NewAfterOrEqualRule,
+		RuleDate:          dateRules.NewDateRule,
+		RuleDateEquals:    dateRules.NewDateEqualsRule,
+		RuleDateFormat:    dateRules.NewDateFormatRule,
 
 		// Numeric rules
 		RuleNumeric:    func(_ []string) (contract.Rule, error) { return numeric.NewNumericRule() },
@@ -252,10 +363,30 @@ func registerDefaultRules(reg contract.Registry, config *contract.Config) error
 		RuleSlug:            func(_ []string) (contract.Rule, error) { return stringRules.NewSlugRule() },
 		RuleDoesntStartWith: stringRules.NewDoesntStartWithRule,
 		RuleDoesntEndWith:   func(p []string) (contract.Rule, error) { return stringRules.NewDoesntEndWithRule(p) },
+		RuleString:          func(_ []string) (contract.Rule, error) { return stringRules.NewStringRule() },
+		RulePassword:        func(p []string) (contract.Rule, error) { return stringRules.NewPasswordRule(p) },
+		RuleStartsWith:      func(p []string) (contract.Rule, error) { return stringRules.NewStartsWithRule(p) },
+		RuleEndsWith:        stringRules.NewEndsWithRule,
+
+		// Inclusion rules
+		RuleIn:    func(p []string) (contract.Rule, error) { return inclusion.NewInRule(p) },
+		RuleNotIn: func(p []string) (contract.Rule, error) { return inclusion.NewNotInRule(p) },
+
+		// Collection rules
+		RuleList: func(p []string) (contract.Rule, error) { return collection.NewListRule(p) },
+		RuleMap:  func(p []string) (contract.Rule, error) { return collection.NewMapRule(p) },
 
 		// Format rules
-		RuleEmail: func(p []string) (contract.Rule, error) { return format.NewEmailRule(p) },
-		RuleURL:   func(p []string) (contract.Rule, error) { return format.NewURLRule(p) },
+		RuleEmail:      func(p []string) (contract.Rule, error) { return format.NewEmailRule(p) },
+		RuleURL:        func(p []string) (contract.Rule, error) { return format.NewURLRule(p) },
+		RuleActiveURL:  func(_ []string) (contract.Rule, error) { return stringRules.NewActiveURLRule() },
+		RuleUUID:       func(p []string) (contract.Rule, error) { return format.NewUUIDRule(p) },
+		RuleJSON:       func(p []string) (contract.Rule, error) { return format.NewJSONRule(p) },
+		RuleRegex:      func(p []string) (contract.Rule, error) { return format.NewRegexRule(p) },
+		RuleIP:         format.NewIPRule,
+		RuleIPv4:       format.NewIPv4Rule,
+		RuleIPv6:       format.NewIPv6Rule,
+		RuleMACAddress: format.NewMACAddressRule,
 
 		// File rules
 		RuleFile:  func(_ []string) (contract.Rule, error) { return file.NewFileRule() },
@@ -264,6 +395,10 @@ func registerDefaultRules(reg contract.Registry, config *contract.Config) error
 
 		// Auth rules
 		RuleCurrentPassword: func(_ []string) (contract.Rule, error) { return authentication.NewCurrentPasswordRule() },
+
+		// Database rules
+		RuleExists: database.NewExistRule,
+		RuleUnique: database.NewUniqueRuleWithParams,
 	}
 
 	// Apply filtering based on config
@@ -287,10 +422,40 @@ func registerDefaultRules(reg contract.Registry, config *contract.Config) error
 
 	// Register filtered rules to the registry
 	for name, creator := range filteredRules {
-		if err := reg.Register(name, creator); err != nil {
+		var err error
+		switch descriptor, ok := DefaultDescriptor(name); {
+		case ok:
+			err = reg.RegisterDescriptor(descriptor, checkedCreator(descriptor, creator))
+		case implicitRules[name]:
+			err = reg.RegisterImplicit(name, creator)
+		default:
+			err = reg.Register(name, creator)
+		}
+		if err != nil {
 			return fmt.Errorf("failed to register rule %s: %w", name, err)
 		}
 	}
 
+	// Register aliases of the rules that were registered
+	for alias, name := range defaultAliases {
+		if _, ok := filteredRules[name]; !ok {
+			continue
+		}
+		if err := reg.RegisterAlias(alias, name); err != nil {
+			return fmt.Errorf("failed to register alias %s: %w", alias, err)
+		}
+	}
+
 	return nil
 }
+
+// checkedCreator checks parameters against the descriptor before creating the rule, so that
+// creators reject the parameter lists their descriptor rejects, such as surplus parameters
+func checkedCreator(descriptor contract.RuleDescriptor, creator contract.RuleCreator) contract.RuleCreator {
+	return func(params []string) (contract.Rule, error) {
+		if err := descriptor.CheckParams(params); err != nil {
+			return nil, err
+		}
+		return creator(params)
+	}
+}
@@ -1,9 +1,39 @@
 package rules
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+)
 
 func TestWithCustomMessage_Option(t *testing.T) {
 	// Just ensure option function executes without panic to cover code path
 	t.Helper()
 	_ = NewRuleRegistry(WithCustomMessage("required", "custom"))
 }
+
+func TestWithCustomImplicitRule_Option(t *testing.T) {
+	creator := func(_ []string) (contract.Rule, error) { return simpleRule{}, nil }
+	reg := NewRuleRegistry(WithCustomImplicitRule("custom_implicit", creator), WithCustomRule("custom_plain", creator))
+	if !reg.IsImplicit("custom_implicit") {
+		t.Fatal("expected custom implicit rule to be registered as implicit")
+	}
+	if !reg.Has("custom_plain") || reg.IsImplicit("custom_plain") {
+		t.Fatal("expected custom plain rule to be registered as non-implicit")
+	}
+}
+
+func TestWithCustomRuleDescriptor_Option(t *testing.T) {
+	creator := func(_ []string) (contract.Rule, error) { return simpleRule{}, nil }
+	descriptor := contract.RuleDescriptor{
+		Name:     "tenant_exists",
+		Category: "custom",
+		Params:   []contract.ParamSpec{{Name: "tenant", Type: contract.ParamString}},
+		Implicit: true,
+	}
+	reg := NewRuleRegistry(WithCustomRuleDescriptor(descriptor, creator))
+	got, ok := reg.Describe("tenant_exists")
+	if !ok || got.Category != "custom" || !reg.IsImplicit("tenant_exists") {
+		t.Fatalf("unexpected descriptor: %+v", got)
+	}
+}
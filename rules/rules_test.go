@@ -37,3 +37,41 @@ func TestNewRuleRegistry_Options(t *testing.T) {
 		t.Fatal("expected custom rule present")
 	}
 }
+
+func TestNewRuleRegistry_ImplicitDefaults(t *testing.T) {
+	reg := NewRuleRegistry()
+	for _, name := range []string{RuleRequired, RuleRequiredIf, RuleAccepted, RulePresent, RuleFilled, RuleProhibited} {
+		if !reg.IsImplicit(name) {
+			t.Errorf("expected %s to be implicit", name)
+		}
+	}
+	for _, name := range []string{RuleEmail, RuleMin, RuleNullable} {
+		if reg.IsImplicit(name) {
+			t.Errorf("did not expect %s to be implicit", name)
+		}
+	}
+}
+
+func TestNewRuleRegistry_Descriptors(t *testing.T) {
+	reg := NewRuleRegistry()
+	for _, name := range reg.List() {
+		if _, ok := defaultDescriptors[name]; !ok {
+			t.Errorf("default rule %s has no descriptor", name)
+		}
+	}
+
+	required, ok := reg.Describe(RuleRequiredIf)
+	if !ok || !required.Implicit || !required.Dependent || required.Category != CategoryConditional {
+		t.Fatalf("unexpected required_if descriptor: %+v", required)
+	}
+	if required.DefaultMessage == "" {
+		t.Fatal("expected default message to be filled in")
+	}
+	if minParams, maxParams := required.Arity(); minParams != 2 || maxParams != -1 {
+		t.Fatalf("unexpected required_if arity: %d..%d", minParams, maxParams)
+	}
+
+	if len(reg.Descriptors()) != reg.Count() {
+		t.Fatal("expected a descriptor per registered rule")
+	}
+}
@@ -1,6 +1,7 @@
 package date_test
 
 import (
+	"strconv"
 	"testing"
 	"time"
 
@@ -28,6 +29,7 @@ func TestBeforeOrEqualRule(t *testing.T) {
 		{"valid: date equal", nowStr, true},
 		{"invalid: date after", tomorrowStr, false},
 		{"invalid: non-string type", 42, false},
+		{"invalid: non-date type", true, false},
 		{"invalid: empty string", "", false},
 		{"invalid: malformed string", "not-a-date", false},
 	}
@@ -45,4 +47,19 @@ func TestBeforeOrEqualRule(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("unix timestamps under the U format", func(t *testing.T) {
+		unixRule, err := date.NewBeforeOrEqualRule([]string{strconv.FormatInt(now.Unix(), 10), "U"})
+		if err != nil {
+			t.Fatalf("failed to create rule: %v", err)
+		}
+		day := int64(24 * time.Hour / time.Second)
+		cases := map[any]bool{now.Unix() - day: true, now.Unix(): true, now.Unix() + day: false}
+		for value, wantValid := range cases {
+			err := unixRule.Validate(contract.NewValidationContext("date_field", value, nil, nil))
+			if wantValid != (err == nil) {
+				t.Errorf("value %v: wantValid %v, got error %v", value, wantValid, err)
+			}
+		}
+	})
 }
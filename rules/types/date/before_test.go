@@ -1,6 +1,7 @@
 package date_test
 
 import (
+	"strconv"
 	"testing"
 	"time"
 
@@ -28,6 +29,7 @@ func TestBeforeRule(t *testing.T) {
 		{"invalid: date after", tomorrowStr, false},
 		{"invalid: date equal", nowStr, false},
 		{"invalid: non-string type", 42, false},
+		{"invalid: non-date type", true, false},
 		{"invalid: empty string", "", false},
 		{"invalid: malformed string", "not-a-date", false},
 	}
@@ -46,4 +48,19 @@ func TestBeforeRule(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("unix timestamps under the U format", func(t *testing.T) {
+		unixRule, err := date.NewBeforeRule([]string{strconv.FormatInt(now.Unix(), 10), "U"})
+		if err != nil {
+			t.Fatalf("failed to create rule: %v", err)
+		}
+		day := int64(24 * time.Hour / time.Second)
+		cases := map[any]bool{now.Unix() - day: true, now.Unix(): false, now.Unix() + day: false}
+		for value, wantValid := range cases {
+			err := unixRule.Validate(contract.NewValidationContext("date_field", value, nil, nil))
+			if wantValid != (err == nil) {
+				t.Errorf("value %v: wantValid %v, got error %v", value, wantValid, err)
+			}
+		}
+	})
 }
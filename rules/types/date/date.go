@@ -12,41 +12,47 @@ import (
 const (
 	dateRuleName        = "date"
 	dateRuleDefaultMsg  = "the :attribute is not a valid date"
-	dateRuleParseErrMsg = "invalid date format for date rule: %w"
+	dateRuleParseErrMsg = "invalid date format for date rule: %q does not match %s"
 )
 
-// Rule checks if a value is a valid date string based on a given format.
+// Rule checks if a value is a valid date: a string in one of the given formats, a time.Time or
+// *time.Time value, or a Unix timestamp when the formats include "U".
 type Rule struct {
 	common.BaseRule
-	format string
+	layouts []dateLayout
+	zone    *time.Location
 }
 
-// NewDateRule creates a new Rule with an optional custom time format.
+// NewDateRule creates a new Rule.
+// parameters[0] = optional "|"-separated formats: Go layouts, layout names or PHP formats (defaults to RFC3339)
+// parameters[1] = optional timezone of dates without a zone
 func NewDateRule(parameters []string) (contract.Rule, error) {
-	format := time.RFC3339
-	if len(parameters) > 0 && parameters[0] != "" {
-		format = parameters[0]
+	layouts, zone, err := layoutParams(parameters)
+	if err != nil {
+		return nil, err
 	}
 
 	return &Rule{
 		BaseRule: common.NewBaseRule(dateRuleName, dateRuleDefaultMsg, parameters),
-		format:   format,
+		layouts:  layouts,
+		zone:     zone,
 	}, nil
 }
 
-// Validate checks if the context value is a string and a valid date in the specified format.
+// Validate checks that the context value is a date.
 func (r *Rule) Validate(ctx contract.RuleContext) error {
 	if r.ShouldSkipValidation(ctx.Value()) {
 		return nil
 	}
 
-	strVal, ok := ctx.Value().(string)
-	if !ok {
+	_, loc := clockOf(ctx, r.zone)
+	_, isDate, parsed := toTime(ctx.Value(), r.layouts, loc)
+	if !isDate {
 		return errors.New(dateRuleDefaultMsg)
 	}
-
-	if _, err := time.Parse(r.format, strVal); err != nil {
-		return fmt.Errorf(dateRuleParseErrMsg, err)
+	if !parsed {
+		return formatReason(dateRuleName, formatList(r.layouts),
+			fmt.Sprintf(dateRuleParseErrMsg, fmt.Sprint(ctx.Value()), formatList(r.layouts)))
 	}
 
 	return nil
@@ -3,6 +3,7 @@ package date
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/next-trace/scg-validator/contract"
@@ -20,65 +21,74 @@ const (
 	ComparisonEqual
 )
 
-// BaseDateComparisonRule provides common functionality for date comparison rules
+// BaseDateComparisonRule provides common functionality for date comparison rules. The comparison
+// date is resolved when validating, so it may be a date, a keyword such as "today", a relative
+// expression such as "+7 days" or the path of another field.
 type BaseDateComparisonRule struct {
 	common.BaseRule
-	comparisonDate     time.Time
-	format             string
+	reference          string
+	layouts            []dateLayout
+	zone               *time.Location
 	comparisonType     ComparisonType
 	ruleName           string
+	parseErrorMsg      string
 	typeErrorMsg       string
 	validationErrorMsg string
 }
 
-// NewBaseDateComparisonRule creates a new base date comparison rule
+// NewBaseDateComparisonRule creates a new base date comparison rule.
+// parameters[0] = comparison date, keyword, relative expression or field path
+// parameters[1] = optional "|"-separated formats: Go layouts, layout names or PHP formats (defaults to RFC3339)
+// parameters[2] = optional timezone of dates without a zone and of relative dates
 func NewBaseDateComparisonRule(
 	ruleName, defaultTemplate, missingParamError, parseError, typeError, validationError string,
 	comparisonType ComparisonType,
 	parameters []string,
 ) (*BaseDateComparisonRule, error) {
-	if len(parameters) == 0 {
+	if len(parameters) == 0 || strings.TrimSpace(parameters[0]) == "" {
 		return nil, errors.New(missingParamError)
 	}
 
-	format := time.RFC3339
-	if len(parameters) > 1 {
-		format = parameters[1]
-	}
-
-	parsedDate, err := time.Parse(format, parameters[0])
+	layouts, zone, err := layoutParams(parameters[1:])
 	if err != nil {
-		return nil, fmt.Errorf(parseError, err)
+		return nil, err
 	}
 
 	return &BaseDateComparisonRule{
 		BaseRule:           common.NewBaseRule(ruleName, defaultTemplate, parameters),
-		comparisonDate:     parsedDate,
-		format:             format,
+		reference:          strings.TrimSpace(parameters[0]),
+		layouts:            layouts,
+		zone:               zone,
 		comparisonType:     comparisonType,
 		ruleName:           ruleName,
+		parseErrorMsg:      parseError,
 		typeErrorMsg:       typeError,
 		validationErrorMsg: validationError,
 	}, nil
 }
 
-// Validate performs the date comparison validation
+// Validate performs the date comparison validation. Values may be date strings in one of the
+// formats, time.Time or *time.Time values, or Unix timestamps when the formats include "U".
 func (r *BaseDateComparisonRule) Validate(ctx contract.RuleContext) error {
 	if r.ShouldSkipValidation(ctx.Value()) {
 		return nil
 	}
 
-	val, ok := ctx.Value().(string)
-	if !ok {
+	_, loc := clockOf(ctx, r.zone)
+	value, isDate, parsed := toTime(ctx.Value(), r.layouts, loc)
+	if !isDate {
 		return errors.New(r.typeErrorMsg)
 	}
+	if !parsed {
+		return formatReason(r.ruleName, formatList(r.layouts), r.typeErrorMsg)
+	}
 
-	parsedVal, err := time.Parse(r.format, val)
+	comparisonDate, err := resolveReference(ctx, r.reference, r.layouts, r.zone)
 	if err != nil {
-		return errors.New(r.typeErrorMsg)
+		return fmt.Errorf(r.parseErrorMsg, err)
 	}
 
-	if r.compareDate(parsedVal) {
+	if r.compareDate(value, comparisonDate) {
 		return nil
 	}
 
@@ -86,18 +96,18 @@ func (r *BaseDateComparisonRule) Validate(ctx contract.RuleContext) error {
 }
 
 // compareDate performs the actual date comparison based on the comparison type
-func (r *BaseDateComparisonRule) compareDate(value time.Time) bool {
+func (r *BaseDateComparisonRule) compareDate(value, comparisonDate time.Time) bool {
 	switch r.comparisonType {
 	case ComparisonAfter:
-		return value.After(r.comparisonDate)
+		return value.After(comparisonDate)
 	case ComparisonAfterOrEqual:
-		return value.After(r.comparisonDate) || value.Equal(r.comparisonDate)
+		return value.After(comparisonDate) || value.Equal(comparisonDate)
 	case ComparisonBefore:
-		return value.Before(r.comparisonDate)
+		return value.Before(comparisonDate)
 	case ComparisonBeforeOrEqual:
-		return value.Before(r.comparisonDate) || value.Equal(r.comparisonDate)
+		return value.Before(comparisonDate) || value.Equal(comparisonDate)
 	case ComparisonEqual:
-		return value.Equal(r.comparisonDate)
+		return value.Equal(comparisonDate)
 	default:
 		return false
 	}
@@ -1,13 +1,7 @@
 package date
 
 import (
-	"errors"
-	"fmt"
-	"strings"
-	"time"
-
 	"github.com/next-trace/scg-validator/contract"
-	"github.com/next-trace/scg-validator/rules/common"
 )
 
 const (
@@ -15,62 +9,34 @@ const (
 	dateEqualsDefaultMsg         = "the :attribute must be a date equal to :date"
 	dateEqualsMissingParamMsg    = "date_equals rule requires a date parameter"
 	dateEqualsInvalidFormatError = "invalid date format for date_equals rule: %w"
+	dateEqualsInvalidTypeError   = "the value must be a string to validate as a date"
+	dateEqualsFailedError        = "the date must be equal to the comparison date"
 )
 
 // EqualsRule validates that a value is a date equal to a target date.
 type EqualsRule struct {
-	common.BaseRule
-	comparisonDate time.Time
-	format         string
+	*BaseDateComparisonRule
 }
 
 // NewDateEqualsRule creates a new EqualsRule with the given parameters.
+// parameters[0] = comparison date, keyword, relative expression or field path
+// parameters[1] = optional format (defaults to RFC3339)
 func NewDateEqualsRule(parameters []string) (contract.Rule, error) {
-	if len(parameters) == 0 {
-		return nil, errors.New(dateEqualsMissingParamMsg)
-	}
-
-	dateStr := parameters[0]
-	format := time.RFC3339
-	if len(parameters) > 1 && parameters[1] != "" {
-		format = parameters[1]
-	}
-
-	parsedDate, err := time.Parse(format, dateStr)
+	base, err := NewBaseDateComparisonRule(
+		dateEqualsRuleName,
+		dateEqualsDefaultMsg,
+		dateEqualsMissingParamMsg,
+		dateEqualsInvalidFormatError,
+		dateEqualsInvalidTypeError,
+		dateEqualsFailedError,
+		ComparisonEqual,
+		parameters,
+	)
 	if err != nil {
-		return nil, fmt.Errorf(dateEqualsInvalidFormatError, err)
+		return nil, err
 	}
 
 	return &EqualsRule{
-		BaseRule:       common.NewBaseRule(dateEqualsRuleName, dateEqualsDefaultMsg, parameters),
-		comparisonDate: parsedDate,
-		format:         format,
+		BaseDateComparisonRule: base,
 	}, nil
 }
-
-// Validate checks if the context value equals the comparison date.
-func (r *EqualsRule) Validate(ctx contract.RuleContext) error {
-	if r.ShouldSkipValidation(ctx.Value()) {
-		return nil
-	}
-
-	valueStr, ok := ctx.Value().(string)
-	if !ok {
-		return errors.New(dateEqualsDefaultMsg)
-	}
-
-	parsedValue, err := time.Parse(r.format, valueStr)
-	if err != nil {
-		return fmt.Errorf(dateEqualsInvalidFormatError, err)
-	}
-
-	if parsedValue.Equal(r.comparisonDate) {
-		return nil
-	}
-
-	return fmt.Errorf("%s", strings.Replace(dateEqualsDefaultMsg, ":date", r.Parameters()[0], 1))
-}
-
-func (r *EqualsRule) Name() string {
-	return dateEqualsRuleName
-}
@@ -0,0 +1,73 @@
+package date
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/common"
+)
+
+const (
+	dateFormatRuleName          = "date_format"
+	dateFormatRuleDefaultMsg    = "the :attribute does not match the format :param0"
+	dateFormatRuleMissingMsg    = "date_format rule requires at least one layout"
+	dateFormatRuleInvalidMsg    = "the :attribute must be a string"
+	dateFormatRuleNoMatchFormat = "%q does not match any of the layouts %s"
+)
+
+// FormatRule checks that a value is a date string matching one of the given formats, or a Unix
+// timestamp when the formats include "U".
+type FormatRule struct {
+	common.BaseRule
+	layouts []dateLayout
+}
+
+// NewDateFormatRule creates a FormatRule accepting any of the formats in parameters. Formats are
+// Go layouts, layout names such as RFC3339 or PHP formats such as "Y-m-d H:i".
+// Usage: date_format:Y-m-d or date_format:2006-01-02,RFC3339
+func NewDateFormatRule(parameters []string) (contract.Rule, error) {
+	var layouts []dateLayout
+	for _, param := range parameters {
+		parsed, err := parseLayouts(param)
+		if err != nil {
+			return nil, err
+		}
+		layouts = append(layouts, parsed...)
+	}
+	if len(layouts) == 0 {
+		return nil, errors.New(dateFormatRuleMissingMsg)
+	}
+
+	return &FormatRule{
+		BaseRule: common.NewBaseRule(dateFormatRuleName, dateFormatRuleDefaultMsg, parameters),
+		layouts:  layouts,
+	}, nil
+}
+
+// Validate checks that the value parses with at least one of the layouts.
+func (r *FormatRule) Validate(ctx contract.RuleContext) error {
+	if r.ShouldSkipValidation(ctx.Value()) {
+		return nil
+	}
+
+	strVal, ok := ctx.Value().(string)
+	if !ok {
+		// Numbers are accepted as Unix timestamps under the "U" format
+		if _, isNumber, parsed := unixTime(ctx.Value(), time.UTC); isNumber && parsed && hasUnixFormat(r.layouts) {
+			return nil
+		}
+		return errors.New(dateFormatRuleInvalidMsg)
+	}
+
+	if _, ok := parseString(strVal, r.layouts, time.UTC); ok {
+		return nil
+	}
+	return formatReason(dateFormatRuleName, formatList(r.layouts),
+		fmt.Sprintf(dateFormatRuleNoMatchFormat, strVal, formatList(r.layouts)))
+}
+
+func (r *FormatRule) Name() string {
+	return dateFormatRuleName
+}
@@ -0,0 +1,65 @@
+package date_test
+
+import (
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/types/date"
+)
+
+func TestDateFormatRule(t *testing.T) {
+	rule, err := date.NewDateFormatRule([]string{"2006-01-02", "02/01/2006"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		value   any
+		wantErr bool
+	}{
+		{"first layout", "2024-02-29", false},
+		{"second layout", "29/02/2024", false},
+		{"other layout", "Feb 29 2024", true},
+		{"impossible date", "2023-02-29", true},
+		{"non-string", 20240229, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := rule.Validate(contract.NewValidationContext("day", tt.value, nil, nil))
+			if tt.wantErr != (err != nil) {
+				t.Errorf("expected error=%v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	reasons := contract.ReasonsOf(rule.Validate(contract.NewValidationContext("day", "nope", nil, nil)))
+	if len(reasons) != 1 || reasons[0].Key != "date_format.format" {
+		t.Fatalf("expected a date_format.format reason, got %+v", reasons)
+	}
+}
+
+func TestDateFormatRule_RequiresLayout(t *testing.T) {
+	if _, err := date.NewDateFormatRule(nil); err == nil {
+		t.Fatal("expected an error without layouts")
+	}
+}
+
+func TestDateFormatRule_PHPFormats(t *testing.T) {
+	rule, err := date.NewDateFormatRule([]string{"Y-m-d H:i"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := rule.Validate(contract.NewValidationContext("at", "2024-02-29 13:45", nil, nil)); err != nil {
+		t.Errorf("expected the PHP format to match, got %v", err)
+	}
+	reasons := contract.ReasonsOf(rule.Validate(contract.NewValidationContext("at", "2024-02-29", nil, nil)))
+	if len(reasons) != 1 || reasons[0].Params["format"] != "Y-m-d H:i" {
+		t.Fatalf("expected the format as written in the reason, got %+v", reasons)
+	}
+
+	if _, err := date.NewDateFormatRule([]string{"Y-m-d Q"}); err == nil {
+		t.Fatal("expected an error for an unsupported PHP token")
+	}
+}
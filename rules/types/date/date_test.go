@@ -1,6 +1,7 @@
 package date_test
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -34,6 +35,11 @@ func TestDateRule(t *testing.T) {
 			value:   123,
 			wantErr: true,
 		},
+		{
+			name:    "non-date type",
+			value:   true,
+			wantErr: true,
+		},
 		{
 			name:    "empty string",
 			value:   "",
@@ -75,3 +81,93 @@ func TestDateRule(t *testing.T) {
 		}
 	})
 }
+
+func TestDateRules_FormatReason(t *testing.T) {
+	tests := []struct {
+		create func([]string) (contract.Rule, error)
+		params []string
+		key    string
+	}{
+		{date.NewDateRule, []string{"2006-01-02"}, "date.format"},
+		{date.NewAfterRule, []string{"2024-01-01", "2006-01-02"}, "after.format"},
+		{date.NewBeforeRule, []string{"2024-01-01", "2006-01-02"}, "before.format"},
+		{date.NewAfterOrEqualRule, []string{"2024-01-01", "2006-01-02"}, "after_or_equal.format"},
+		{date.NewDateEqualsRule, []string{"2024-01-01", "2006-01-02"}, "date_equals.format"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			rule, err := tt.create(tt.params)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			ctx := contract.NewValidationContext("d", "01/02/2024", tt.params, nil)
+			reasons := contract.ReasonsOf(rule.Validate(ctx))
+			if len(reasons) != 1 || reasons[0].Key != tt.key || reasons[0].Params["format"] != "2006-01-02" {
+				t.Errorf("unexpected reasons: %v", reasons)
+			}
+		})
+	}
+}
+
+func TestDateRule_NativeValuesAndLayouts(t *testing.T) {
+	rule, err := date.NewDateRule([]string{"2006-01-02|RFC3339"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	moment := time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC)
+	for _, value := range []any{"2024-02-29", "2024-02-29T12:00:00Z", moment, &moment} {
+		if err := rule.Validate(contract.NewValidationContext("day", value, nil, nil)); err != nil {
+			t.Errorf("value %v: unexpected error %v", value, err)
+		}
+	}
+	for _, value := range []any{"29/02/2024", (*time.Time)(nil), false, int64(1709208000), 1709208000.5} {
+		if err := rule.Validate(contract.NewValidationContext("day", value, nil, nil)); err == nil {
+			t.Errorf("value %v: expected an error", value)
+		}
+	}
+
+	if _, err := date.NewDateRule([]string{"Y-m-d", "Nowhere/Town"}); err == nil {
+		t.Error("expected an error for an unknown timezone")
+	}
+}
+
+func TestDateRules_UnixTimestamps(t *testing.T) {
+	plain, _ := date.NewDateRule(nil)
+	if err := plain.Validate(contract.NewValidationContext("day", 1709208000, nil, nil)); err == nil {
+		t.Error("expected date to reject a bare integer")
+	}
+
+	unix, _ := date.NewDateRule([]string{"Y-m-d|U"})
+	format, _ := date.NewDateFormatRule([]string{"U"})
+	for _, value := range []any{1709208000, int64(1709208000), 1709208000.5, json.Number("1709208000"), "1709208000"} {
+		if err := unix.Validate(contract.NewValidationContext("day", value, nil, nil)); err != nil {
+			t.Errorf("date:Y-m-d|U, value %v: unexpected error %v", value, err)
+		}
+		if err := format.Validate(contract.NewValidationContext("day", value, nil, nil)); err != nil {
+			t.Errorf("date_format:U, value %v: unexpected error %v", value, err)
+		}
+	}
+
+	ymd, _ := date.NewDateFormatRule([]string{"Y-m-d"})
+	if err := ymd.Validate(contract.NewValidationContext("day", 1709208000, nil, nil)); err == nil {
+		t.Error("expected date_format without U to reject an integer")
+	}
+}
+
+func TestDateComparison_ZoneParameter(t *testing.T) {
+	// Midnight of March 11 in Tokyo is still March 10 in UTC
+	rule, err := date.NewAfterOrEqualRule([]string{"2024-03-11", "Y-m-d", "+09:00"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tokyoMidnight := time.Date(2024, 3, 10, 15, 0, 0, 0, time.UTC)
+	if err := rule.Validate(contract.NewValidationContext("at", tokyoMidnight, nil, nil)); err != nil {
+		t.Errorf("expected the comparison in the zone of the rule, got %v", err)
+	}
+	beforeMidnight := contract.NewValidationContext("at", tokyoMidnight.Add(-time.Second), nil, nil)
+	if err := rule.Validate(beforeMidnight); err == nil {
+		t.Error("expected an instant before midnight in Tokyo to fail")
+	}
+}
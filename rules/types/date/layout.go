@@ -0,0 +1,274 @@
+package date
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// layoutSeparator separates the acceptable layouts of a parameter, e.g. "2006-01-02|RFC3339".
+// In rule strings the pipe must be escaped as "\|".
+const layoutSeparator = "|"
+
+// unixFormat is the PHP format of Unix timestamps, which has no Go layout
+const unixFormat = "U"
+
+const (
+	layoutUnsupportedTokenMsg = "unsupported date format token %q in %q"
+	layoutUnknownZoneMsg      = "unknown timezone %q"
+)
+
+// namedLayouts are the Go layout constants that can be referred to by name
+var namedLayouts = map[string]string{
+	"ANSIC":       time.ANSIC,
+	"UnixDate":    time.UnixDate,
+	"RubyDate":    time.RubyDate,
+	"RFC822":      time.RFC822,
+	"RFC822Z":     time.RFC822Z,
+	"RFC850":      time.RFC850,
+	"RFC1123":     time.RFC1123,
+	"RFC1123Z":    time.RFC1123Z,
+	"RFC3339":     time.RFC3339,
+	"RFC3339Nano": time.RFC3339Nano,
+	"Kitchen":     time.Kitchen,
+	"Stamp":       time.Stamp,
+	"StampMilli":  time.StampMilli,
+	"StampMicro":  time.StampMicro,
+	"StampNano":   time.StampNano,
+	"DateTime":    time.DateTime,
+	"DateOnly":    time.DateOnly,
+	"TimeOnly":    time.TimeOnly,
+}
+
+// phpTokens maps PHP date format characters to Go layout elements
+var phpTokens = map[rune]string{
+	'd': "02", 'j': "2", 'D': "Mon", 'l': "Monday",
+	'm': "01", 'n': "1", 'M': "Jan", 'F': "January",
+	'Y': "2006", 'y': "06",
+	'a': "pm", 'A': "PM",
+	'g': "3", 'h': "03", 'G': "15", 'H': "15",
+	'i': "04", 's': "05", 'v': "000", 'u': "000000",
+	'T': "MST", 'O': "-0700", 'P': "-07:00", 'p': "Z07:00",
+}
+
+// dateLayout is an acceptable date layout: the format as written in the rule and its Go layout
+type dateLayout struct {
+	format string
+	layout string
+}
+
+// parseLayouts reads the "|"-separated formats of a parameter. Each format is the name of a Go
+// layout constant such as "RFC3339", a Go layout such as "2006-01-02", or a PHP/Laravel format
+// such as "Y-m-d H:i". Formats without digits are read as PHP formats.
+func parseLayouts(param string) ([]dateLayout, error) {
+	var layouts []dateLayout
+	for _, format := range strings.Split(param, layoutSeparator) {
+		if format = strings.TrimSpace(format); format == "" {
+			continue
+		}
+		layout, err := goLayout(format)
+		if err != nil {
+			return nil, err
+		}
+		layouts = append(layouts, dateLayout{format: format, layout: layout})
+	}
+	return layouts, nil
+}
+
+// goLayout returns the Go layout of a format
+func goLayout(format string) (string, error) {
+	if layout, ok := namedLayouts[format]; ok {
+		return layout, nil
+	}
+	if format == unixFormat || strings.ContainsAny(format, "0123456789") {
+		return format, nil
+	}
+	return phpLayout(format)
+}
+
+// phpLayout translates a PHP date format into a Go layout. A backslash makes the next character
+// literal.
+func phpLayout(format string) (string, error) {
+	var b strings.Builder
+	escaped := false
+	for _, r := range format {
+		switch {
+		case escaped:
+			b.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case phpTokens[r] != "":
+			b.WriteString(phpTokens[r])
+		case r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z':
+			return "", fmt.Errorf(layoutUnsupportedTokenMsg, string(r), format)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String(), nil
+}
+
+// formatList renders layouts the way they were written, for messages
+func formatList(layouts []dateLayout) string {
+	formats := make([]string, len(layouts))
+	for i, l := range layouts {
+		formats[i] = l.format
+	}
+	return strings.Join(formats, ", ")
+}
+
+// parseZone reads a timezone parameter: an IANA name such as "Europe/Paris", "UTC", "Local" or a
+// fixed offset such as "+09:00"
+func parseZone(name string) (*time.Location, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, nil
+	}
+	if offset, err := time.Parse("-07:00", name); err == nil {
+		_, seconds := offset.Zone()
+		return time.FixedZone(name, seconds), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf(layoutUnknownZoneMsg, name)
+	}
+	return loc, nil
+}
+
+// parseString parses a date string with the first matching layout; dates without a zone are
+// read in loc
+func parseString(value string, layouts []dateLayout, loc *time.Location) (time.Time, bool) {
+	for _, l := range layouts {
+		if l.layout == unixFormat {
+			if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+				return time.Unix(seconds, 0).In(loc), true
+			}
+			continue
+		}
+		if t, err := time.ParseInLocation(l.layout, value, loc); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// toTime converts a date value: a string in one of the layouts, a time.Time or non-nil
+// *time.Time, or, when the layouts include "U", a Unix timestamp in seconds given as an integer,
+// float or json.Number. The second result is false for values of other types, the third for
+// values that do not match any layout.
+func toTime(value any, layouts []dateLayout, loc *time.Location) (t time.Time, isDate, parsed bool) {
+	switch v := value.(type) {
+	case string:
+		t, parsed = parseString(v, layouts, loc)
+		return t, true, parsed
+	case time.Time:
+		return v, true, true
+	case *time.Time:
+		if v == nil {
+			return time.Time{}, false, false
+		}
+		return *v, true, true
+	}
+	if hasUnixFormat(layouts) {
+		return unixTime(value, loc)
+	}
+	return time.Time{}, false, false
+}
+
+// hasUnixFormat reports whether the layouts accept Unix timestamps
+func hasUnixFormat(layouts []dateLayout) bool {
+	for _, l := range layouts {
+		if l.layout == unixFormat {
+			return true
+		}
+	}
+	return false
+}
+
+// unixTime converts a Unix timestamp in seconds given as an integer, float or json.Number. The
+// second result is false for values of other types, the third for json.Number values that are
+// not numbers.
+func unixTime(value any, loc *time.Location) (t time.Time, isNumber, parsed bool) {
+	switch v := value.(type) {
+	case json.Number:
+		if seconds, err := v.Int64(); err == nil {
+			return time.Unix(seconds, 0).In(loc), true, true
+		}
+		if seconds, err := v.Float64(); err == nil {
+			return unixFloat(seconds, loc), true, true
+		}
+		return time.Time{}, true, false
+	case float32:
+		return unixFloat(float64(v), loc), true, true
+	case float64:
+		return unixFloat(v, loc), true, true
+	}
+	if seconds, ok := unixSeconds(value); ok {
+		return time.Unix(seconds, 0).In(loc), true, true
+	}
+	return time.Time{}, false, false
+}
+
+// unixSeconds converts integer values to seconds since the Unix epoch
+func unixSeconds(value any) (int64, bool) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), true
+	case int8:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case uint:
+		return int64(v), v <= math.MaxInt64
+	case uint8:
+		return int64(v), true
+	case uint16:
+		return int64(v), true
+	case uint32:
+		return int64(v), true
+	case uint64:
+		return int64(v), v <= math.MaxInt64
+	}
+	return 0, false
+}
+
+// unixFloat converts fractional seconds since the Unix epoch
+func unixFloat(seconds float64, loc *time.Location) time.Time {
+	whole, frac := math.Modf(seconds)
+	return time.Unix(int64(whole), int64(frac*float64(time.Second))).In(loc)
+}
+
+// defaultLayouts are used when a rule is given no format
+var defaultLayouts = []dateLayout{{format: "RFC3339", layout: time.RFC3339}}
+
+// layoutParams reads the optional format and timezone parameters shared by the date rules,
+// e.g. ["2006-01-02|RFC3339", "Europe/Paris"]. Without formats RFC3339 is used.
+func layoutParams(parameters []string) ([]dateLayout, *time.Location, error) {
+	layouts := defaultLayouts
+	if len(parameters) > 0 {
+		parsed, err := parseLayouts(parameters[0])
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(parsed) > 0 {
+			layouts = parsed
+		}
+	}
+
+	var zone *time.Location
+	if len(parameters) > 1 {
+		var err error
+		if zone, err = parseZone(parameters[1]); err != nil {
+			return nil, nil, err
+		}
+	}
+	return layouts, zone, nil
+}
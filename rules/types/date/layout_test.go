@@ -0,0 +1,98 @@
+package date
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestPHPLayout(t *testing.T) {
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"Y-m-d", "2006-01-02"},
+		{"Y-m-d H:i:s", "2006-01-02 15:04:05"},
+		{"d/m/y g:i A", "02/01/06 3:04 PM"},
+		{"D, d M Y H:i:s O", "Mon, 02 Jan 2006 15:04:05 -0700"},
+		{`Y-m-d\TH:i:sP`, "2006-01-02T15:04:05-07:00"},
+		{"l jS", ""},
+	}
+	for _, tt := range tests {
+		got, err := phpLayout(tt.format)
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("%s: expected an unsupported token error, got %q", tt.format, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("%s: got %q, %v; want %q", tt.format, got, err, tt.want)
+		}
+	}
+}
+
+func TestParseLayouts(t *testing.T) {
+	layouts, err := parseLayouts("2006-01-02|RFC3339|d.m.Y")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"2006-01-02", time.RFC3339, "02.01.2006"}
+	if len(layouts) != len(want) {
+		t.Fatalf("unexpected layouts: %+v", layouts)
+	}
+	for i, l := range layouts {
+		if l.layout != want[i] {
+			t.Errorf("layout %d: got %q, want %q", i, l.layout, want[i])
+		}
+	}
+	if got := formatList(layouts); got != "2006-01-02, RFC3339, d.m.Y" {
+		t.Errorf("unexpected format list %q", got)
+	}
+}
+
+func TestToTime(t *testing.T) {
+	layouts, _ := parseLayouts("Y-m-d|U")
+	moment := time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		value  any
+		want   time.Time
+		isDate bool
+	}{
+		{"string", "2024-02-29", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), true},
+		{"unix string", "1709208000", moment, true},
+		{"time.Time", moment, moment, true},
+		{"*time.Time", &moment, moment, true},
+		{"nil *time.Time", (*time.Time)(nil), time.Time{}, false},
+		{"int64", int64(1709208000), moment, true},
+		{"float64", 1709208000.5, moment.Add(500 * time.Millisecond), true},
+		{"json.Number", json.Number("1709208000"), moment, true},
+		{"bool", true, time.Time{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, isDate, parsed := toTime(tt.value, layouts, time.UTC)
+			if isDate != tt.isDate || parsed != tt.isDate || !got.Equal(tt.want) {
+				t.Errorf("got %v, %v, %v; want %v", got, isDate, parsed, tt.want)
+			}
+		})
+	}
+
+	if _, isDate, parsed := toTime("29.02.2024", layouts, time.UTC); !isDate || parsed {
+		t.Error("expected a string in another format to be a date that does not parse")
+	}
+}
+
+func TestParseZone(t *testing.T) {
+	if loc, err := parseZone("+09:00"); err != nil || time.Date(2024, 1, 1, 0, 0, 0, 0, loc).UTC().Hour() != 15 {
+		t.Errorf("unexpected offset zone %v, %v", loc, err)
+	}
+	if loc, err := parseZone("UTC"); err != nil || loc != time.UTC {
+		t.Errorf("unexpected UTC zone %v, %v", loc, err)
+	}
+	if _, err := parseZone("Mars/Olympus"); err == nil {
+		t.Error("expected an error for an unknown zone")
+	}
+}
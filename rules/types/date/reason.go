@@ -0,0 +1,16 @@
+package date
+
+import (
+	"github.com/next-trace/scg-validator/contract"
+)
+
+// formatReasonSuffix is appended to the rule name to form the key of the failure reason
+// reported for values that do not match the expected layout, e.g. "after.format"
+const formatReasonSuffix = ".format"
+
+// formatReason reports a value that cannot be parsed with layout. The reason message can use
+// the :format placeholder.
+func formatReason(rule, layout, message string) error {
+	return contract.FailWith(contract.NewReason(rule+formatReasonSuffix, message,
+		map[string]string{"format": layout}))
+}
@@ -0,0 +1,133 @@
+package date
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/utils"
+)
+
+// Keywords accepted as comparison dates, evaluated against the clock of the validation
+const (
+	keywordNow       = "now"
+	keywordToday     = "today"
+	keywordTomorrow  = "tomorrow"
+	keywordYesterday = "yesterday"
+)
+
+const (
+	referenceUnresolvedMsg   = "the comparison date %q is neither a date, a relative date nor a field"
+	referenceFieldInvalidMsg = "the field %s does not hold a date in the expected format"
+)
+
+// clockOf returns the clock and timezone of the validation. A zone given as rule parameter
+// takes precedence over the timezone of the validation, which defaults to UTC; the clock
+// defaults to the system clock.
+func clockOf(ctx contract.RuleContext, zone *time.Location) (contract.Clock, *time.Location) {
+	clock, ok := contract.ClockFrom(ctx.Context())
+	if !ok {
+		clock = contract.SystemClock
+	}
+	if zone != nil {
+		return clock, zone
+	}
+	loc, ok := contract.LocationFrom(ctx.Context())
+	if !ok {
+		loc = time.UTC
+	}
+	return clock, loc
+}
+
+// resolveReference resolves a comparison date parameter at validation time. It is tried as a
+// date in one of the layouts, then as a keyword or relative expression such as "today",
+// "+7 days" or "tomorrow -2 hours", and finally as the path of another field holding a date.
+func resolveReference(
+	ctx contract.RuleContext, reference string, layouts []dateLayout, zone *time.Location,
+) (time.Time, error) {
+	clock, loc := clockOf(ctx, zone)
+	if t, ok := parseString(reference, layouts, loc); ok {
+		return t, nil
+	}
+	if t, ok := relativeDate(reference, clock.Now().In(loc)); ok {
+		return t, nil
+	}
+
+	other, exists := utils.GetPath(ctx.Data(), reference)
+	if !exists {
+		return time.Time{}, fmt.Errorf(referenceUnresolvedMsg, reference)
+	}
+	if t, _, parsed := toTime(other, layouts, loc); parsed {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf(referenceFieldInvalidMsg, reference)
+}
+
+// relativeDate evaluates a keyword optionally followed by offsets, or offsets alone relative to
+// now, e.g. "today", "+7 days", "yesterday +12 hours" or "-1 month +2 weeks"
+func relativeDate(expr string, now time.Time) (time.Time, bool) {
+	tokens := strings.Fields(strings.ToLower(expr))
+	if len(tokens) == 0 {
+		return time.Time{}, false
+	}
+
+	t := now
+	if base, ok := keywordDate(tokens[0], now); ok {
+		t = base
+		tokens = tokens[1:]
+	}
+	if len(tokens)%2 != 0 {
+		return time.Time{}, false
+	}
+
+	for i := 0; i < len(tokens); i += 2 {
+		amount, err := strconv.Atoi(tokens[i])
+		if err != nil {
+			return time.Time{}, false
+		}
+		var ok bool
+		if t, ok = addUnit(t, amount, tokens[i+1]); !ok {
+			return time.Time{}, false
+		}
+	}
+	return t, true
+}
+
+// keywordDate returns the date a keyword stands for
+func keywordDate(keyword string, now time.Time) (time.Time, bool) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	switch keyword {
+	case keywordNow:
+		return now, true
+	case keywordToday:
+		return today, true
+	case keywordTomorrow:
+		return today.AddDate(0, 0, 1), true
+	case keywordYesterday:
+		return today.AddDate(0, 0, -1), true
+	}
+	return time.Time{}, false
+}
+
+// addUnit adds amount units to t; days and longer units follow the calendar
+func addUnit(t time.Time, amount int, unit string) (time.Time, bool) {
+	switch strings.TrimSuffix(unit, "s") {
+	case "second", "sec":
+		return t.Add(time.Duration(amount) * time.Second), true
+	case "minute", "min":
+		return t.Add(time.Duration(amount) * time.Minute), true
+	case "hour":
+		return t.Add(time.Duration(amount) * time.Hour), true
+	case "day":
+		return t.AddDate(0, 0, amount), true
+	case "week":
+		return t.AddDate(0, 0, 7*amount), true
+	case "month":
+		return t.AddDate(0, amount, 0), true
+	case "year":
+		return t.AddDate(amount, 0, 0), true
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,88 @@
+package date_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/types/date"
+)
+
+func TestDateComparison_RelativeReferences(t *testing.T) {
+	// 2024-03-10 22:30 in UTC is already 2024-03-11 in Tokyo
+	clock := contract.FixedClock(time.Date(2024, 3, 10, 22, 30, 0, 0, time.UTC))
+	tokyo := time.FixedZone("JST", 9*60*60)
+	const layout = "2006-01-02"
+
+	tests := []struct {
+		name       string
+		create     func([]string) (contract.Rule, error)
+		params     []string
+		loc        *time.Location
+		value      string
+		shouldPass bool
+	}{
+		{"after today", date.NewAfterRule, []string{"today", layout}, nil, "2024-03-11", true},
+		{"not after today", date.NewAfterRule, []string{"today", layout}, nil, "2024-03-10", false},
+		{"after_or_equal tomorrow", date.NewAfterOrEqualRule, []string{"tomorrow", layout}, nil, "2024-03-11", true},
+		{"before yesterday", date.NewBeforeRule, []string{"yesterday", layout}, nil, "2024-03-09", false},
+		{"before_or_equal yesterday", date.NewBeforeOrEqualRule, []string{"yesterday", layout}, nil, "2024-03-09", true},
+		{"date_equals today", date.NewDateEqualsRule, []string{"today", layout}, nil, "2024-03-10", true},
+		{"today in Tokyo", date.NewDateEqualsRule, []string{"today", layout}, tokyo, "2024-03-11", true},
+		{"within a week", date.NewBeforeRule, []string{"+7 days", layout}, nil, "2024-03-17", true},
+		{"beyond a week", date.NewBeforeRule, []string{"+7 days", layout}, nil, "2024-03-18", false},
+		{"keyword with offsets", date.NewDateEqualsRule, []string{"today -1 month +2 weeks", layout}, nil,
+			"2024-02-24", true},
+		{"now", date.NewAfterRule, []string{"now"}, nil, "2024-03-10T22:30:01Z", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := tt.create(tt.params)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			requestCtx := contract.WithClock(context.Background(), clock)
+			if tt.loc != nil {
+				requestCtx = contract.WithLocation(requestCtx, tt.loc)
+			}
+			ctx := contract.NewValidationContext("day", tt.value, tt.params, nil)
+			ctx.SetContext(requestCtx)
+
+			err = rule.Validate(ctx)
+			if tt.shouldPass != (err == nil) {
+				t.Errorf("value %s: expected pass=%v, got error %v", tt.value, tt.shouldPass, err)
+			}
+		})
+	}
+}
+
+func TestDateComparison_FieldReferences(t *testing.T) {
+	rule, err := date.NewAfterRule([]string{"trip.start_date", "2006-01-02"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		value      string
+		data       map[string]any
+		shouldPass bool
+	}{
+		{"after the other field", "2024-05-02", map[string]any{"trip": map[string]any{"start_date": "2024-05-01"}}, true},
+		{"same day", "2024-05-01", map[string]any{"trip": map[string]any{"start_date": "2024-05-01"}}, false},
+		{"other field missing", "2024-05-02", map[string]any{}, false},
+		{"other field not a date", "2024-05-02", map[string]any{"trip": map[string]any{"start_date": "soon"}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := rule.Validate(contract.NewValidationContext("end_date", tt.value, nil, tt.data))
+			if tt.shouldPass != (err == nil) {
+				t.Errorf("expected pass=%v, got error %v", tt.shouldPass, err)
+			}
+		})
+	}
+}
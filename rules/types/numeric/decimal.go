@@ -1,6 +1,7 @@
 package numeric
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
 )
 
 const (
@@ -22,6 +24,10 @@ const (
 	decimalRuleErrMsgRangeMismatch        = "the :attribute must have between :min and :max decimal places"
 )
 
+// DecimalReasonBetween is the key of the failure reason reported when the number of decimal
+// places is outside the range of decimal:min,max
+const DecimalReasonBetween = "decimal.between"
+
 type DecimalRule struct {
 	common.BaseRule
 	minDecimals int
@@ -58,19 +64,19 @@ func (r *DecimalRule) Validate(ctx contract.RuleContext) error {
 
 	switch v := ctx.Value().(type) {
 	case float64:
-		decimals = r.countDecimalPlaces(v)
+		decimals = r.countDecimalPlaces(v, 64)
 	case float32:
-		decimals = r.countDecimalPlaces(float64(v))
+		decimals = r.countDecimalPlaces(float64(v), 32)
 	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
 		// Integer types have 0 decimal places
 		decimals = 0
-	case string:
-		// For strings, count decimal places from the original string to preserve trailing zeros
-		decimals = r.countDecimalPlacesFromString(v)
-		// Also validate that it's a valid number
-		if _, err := strconv.ParseFloat(v, 64); err != nil {
+	case string, json.Number:
+		// Count decimal places from the text to preserve trailing zeros, which rules out exponents
+		str := fmt.Sprint(v)
+		if _, err := utils.ParseNumber(str); err != nil || strings.ContainsAny(str, "eE") {
 			return errors.New(decimalRuleErrMsgParseFailed)
 		}
+		decimals = r.countDecimalPlacesFromString(str)
 	default:
 		return errors.New(decimalRuleErrMsgInvalidType)
 	}
@@ -91,13 +97,16 @@ func (r *DecimalRule) Validate(ctx contract.RuleContext) error {
 		return nil
 	}
 
-	msg := strings.ReplaceAll(decimalRuleErrMsgRangeMismatch, ":min", strconv.Itoa(r.minDecimals))
-	msg = strings.ReplaceAll(msg, ":max", strconv.Itoa(r.maxDecimals))
-	return fmt.Errorf("%s", msg)
+	minDecimals, maxDecimals := strconv.Itoa(r.minDecimals), strconv.Itoa(r.maxDecimals)
+	msg := strings.ReplaceAll(decimalRuleErrMsgRangeMismatch, ":min", minDecimals)
+	msg = strings.ReplaceAll(msg, ":max", maxDecimals)
+	return contract.FailWith(contract.NewReason(DecimalReasonBetween, msg,
+		map[string]string{"min": minDecimals, "max": maxDecimals}))
 }
 
-func (r *DecimalRule) countDecimalPlaces(f float64) int {
-	parts := strings.Split(strconv.FormatFloat(f, 'f', -1, 64), ".")
+// countDecimalPlaces counts the decimals of the shortest representation of f at the given bit size
+func (r *DecimalRule) countDecimalPlaces(f float64, bitSize int) int {
+	parts := strings.Split(strconv.FormatFloat(f, 'f', -1, bitSize), ".")
 	if len(parts) == 2 {
 		return len(parts[1])
 	}
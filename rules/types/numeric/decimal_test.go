@@ -1,6 +1,7 @@
 package numeric_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/next-trace/scg-validator/contract"
@@ -87,3 +88,22 @@ func TestDecimalRule_ZeroDecimals(t *testing.T) {
 		{"string with decimals", "12.34", false},
 	})
 }
+
+func TestDecimalRule_ExactValues(t *testing.T) {
+	rule, err := numeric.NewDecimalRule([]string{"2"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	runDecimalTests(t, rule, []struct {
+		name  string
+		value any
+		want  bool
+	}{
+		{"float32 with 1 decimal", float32(1.1), false},
+		{"float32 with 2 decimals", float32(19.99), true},
+		{"json number", json.Number("19.90"), true},
+		{"large decimal string", "123456789012345678901234567890.12", true},
+		{"exponent string", "1.25e1", false},
+	})
+}
@@ -3,8 +3,6 @@ package numeric
 import (
 	"errors"
 	"fmt"
-	"math"
-	"strconv"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
@@ -12,20 +10,19 @@ import (
 )
 
 const (
-	multipleOfRuleName                    = "multiple_of"
-	multipleOfRuleDefaultMsg              = "the :attribute must be a multiple of :value"
-	multipleOfRuleMissingParamMsg         = "multiple_of rule requires a value parameter"
-	multipleOfRuleInvalidParamMsg         = "invalid value parameter for multiple_of rule: %w"
-	multipleOfRuleZeroParamMsg            = "the multiple_of parameter cannot be zero"
-	multipleOfRuleFailedMsg               = "the :attribute must be a multiple of %s"
-	multipleOfRuleInvalidInputMsg         = "the :attribute must be a numeric value"
-	floatEqualityTolerance        float64 = 1e-9
+	multipleOfRuleName            = "multiple_of"
+	multipleOfRuleDefaultMsg      = "the :attribute must be a multiple of :value"
+	multipleOfRuleMissingParamMsg = "multiple_of rule requires a value parameter"
+	multipleOfRuleInvalidParamMsg = "invalid value parameter for multiple_of rule: %w"
+	multipleOfRuleZeroParamMsg    = "the multiple_of parameter cannot be zero"
+	multipleOfRuleFailedMsg       = "the :attribute must be a multiple of %s"
+	multipleOfRuleInvalidInputMsg = "the :attribute must be a numeric value"
 )
 
-// MultipleOfRule checks if a value is a multiple of a given float.
+// MultipleOfRule checks if a value is a multiple of a given number.
 type MultipleOfRule struct {
 	common.BaseRule
-	multiple float64
+	multiple utils.Number
 }
 
 // NewMultipleOfRule constructs a new rule for checking multiples.
@@ -34,12 +31,12 @@ func NewMultipleOfRule(parameters []string) (contract.Rule, error) {
 		return nil, errors.New(multipleOfRuleMissingParamMsg)
 	}
 
-	val, err := strconv.ParseFloat(parameters[0], 64)
+	val, err := utils.ParseNumber(parameters[0])
 	if err != nil {
 		return nil, fmt.Errorf(multipleOfRuleInvalidParamMsg, err)
 	}
 
-	if val == 0 {
+	if val.Sign() == 0 {
 		return nil, errors.New(multipleOfRuleZeroParamMsg)
 	}
 
@@ -56,58 +53,17 @@ func (r *MultipleOfRule) Validate(ctx contract.RuleContext) error {
 		return errors.New(multipleOfRuleInvalidInputMsg)
 	}
 
-	var value float64
-	var err error
-
-	switch v := ctx.Value().(type) {
-	case float64:
-		value = v
-	case float32:
-		value = float64(v)
-	case int:
-		value = float64(v)
-	case int8:
-		value = float64(v)
-	case int16:
-		value = float64(v)
-	case int32:
-		value = float64(v)
-	case int64:
-		value = float64(v)
-	case uint:
-		value = float64(v)
-	case uint8:
-		value = float64(v)
-	case uint16:
-		value = float64(v)
-	case uint32:
-		value = float64(v)
-	case uint64:
-		value = float64(v)
-	case string:
-		// Parse string as numeric value
-		value, err = strconv.ParseFloat(v, 64)
-		if err != nil {
-			return errors.New(multipleOfRuleInvalidInputMsg)
-		}
-	default:
+	value, err := utils.GetAsNumber(ctx.Value())
+	if err != nil {
 		return errors.New(multipleOfRuleInvalidInputMsg)
 	}
 
-	if isMultiple(value, r.multiple) {
+	// Exact values divide exactly; floats tolerate a rounding error
+	if value.IsMultipleOf(r.multiple) {
 		return nil
 	}
 
-	return fmt.Errorf(multipleOfRuleFailedMsg, utils.FloatToString(r.multiple))
-}
-
-// isMultiple checks if `value` is a multiple of `factor` using a tolerance.
-func isMultiple(value, factor float64) bool {
-	if factor == 0 {
-		return false
-	}
-	result := value / factor
-	return math.Abs(result-math.Round(result)) < floatEqualityTolerance
+	return fmt.Errorf(multipleOfRuleFailedMsg, r.multiple)
 }
 
 func (r *MultipleOfRule) Name() string {
@@ -1,6 +1,7 @@
 package numeric_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/next-trace/scg-validator/contract"
@@ -98,3 +99,37 @@ func TestMultipleOfRule_InvalidConstruction(t *testing.T) {
 		}
 	})
 }
+
+func TestMultipleOfRule_ExactDecimals(t *testing.T) {
+	rule, err := numeric.NewMultipleOfRule([]string{"0.1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		value any
+		want  bool
+	}{
+		{"decimal string", "0.3", true},
+		{"json number", json.Number("1234567.8"), true},
+		{"large decimal string", "12345678901234567890.1", true},
+		{"finer decimal", "0.35", false},
+		{"float within rounding error", 0.7, true},
+		{"int", int64(3), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := contract.NewValidationContext("amount", tt.value, nil, nil)
+			err := rule.Validate(ctx)
+
+			if tt.want && err != nil {
+				t.Errorf("expected pass, got error: %v", err)
+			}
+			if !tt.want && err == nil {
+				t.Errorf("expected fail, got success for value: %v", tt.value)
+			}
+		})
+	}
+}
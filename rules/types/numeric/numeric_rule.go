@@ -1,11 +1,12 @@
 package numeric
 
 import (
+	"encoding/json"
 	"errors"
-	"strconv"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
 )
 
 const (
@@ -14,7 +15,7 @@ const (
 	numericRuleErrorMsg   = "the :attribute must be numeric"
 )
 
-// Rule checks whether a value is numeric (int, float, or numeric string).
+// Rule checks whether a value is numeric (int, float, json.Number or numeric string).
 type Rule struct {
 	common.BaseRule
 }
@@ -37,8 +38,8 @@ func (r *Rule) Validate(ctx contract.RuleContext) error {
 		uint, uint8, uint16, uint32, uint64,
 		float32, float64:
 		return nil
-	case string:
-		if _, err := strconv.ParseFloat(v, 64); err == nil {
+	case string, json.Number:
+		if _, err := utils.GetAsNumber(v); err == nil {
 			return nil
 		}
 	}
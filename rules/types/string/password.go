@@ -38,6 +38,17 @@ const (
 	passwordRuleDefaultMinLength = 8
 )
 
+// Failure reasons reported by the password rule, one per unmet requirement
+const (
+	PasswordReasonMin       = "password.min"
+	PasswordReasonLetters   = "password.letters"
+	PasswordReasonMixed     = "password.mixed"
+	PasswordReasonUppercase = "password.uppercase"
+	PasswordReasonLowercase = "password.lowercase"
+	PasswordReasonNumbers   = "password.numbers"
+	PasswordReasonSymbols   = "password.symbols"
+)
+
 // PasswordRule validates a password against complexity constraints.
 type PasswordRule struct {
 	common.BaseRule
@@ -88,7 +99,8 @@ func NewPasswordRule(parameters []string, options ...common.RuleOption) (contrac
 	return r, nil
 }
 
-// Validate applies complexity checks to the password.
+// Validate applies complexity checks to the password. Every unmet requirement is reported as a
+// separate failure reason, e.g. password.symbols.
 func (r *PasswordRule) Validate(ctx contract.RuleContext) error {
 	if r.ShouldSkipValidation(ctx.Value()) {
 		return nil
@@ -99,8 +111,10 @@ func (r *PasswordRule) Validate(ctx contract.RuleContext) error {
 		return errors.New(passwordRuleDefaultMsg)
 	}
 
+	var reasons []contract.Reason
 	if len(val) < r.minLength {
-		return fmt.Errorf(passwordRuleErrMin, r.minLength)
+		reasons = append(reasons, contract.NewReason(PasswordReasonMin,
+			fmt.Sprintf(passwordRuleErrMin, r.minLength), map[string]string{"min": strconv.Itoa(r.minLength)}))
 	}
 
 	var hasLetter, hasUpper, hasLower, hasNumber, hasSymbol bool
@@ -122,26 +136,26 @@ func (r *PasswordRule) Validate(ctx contract.RuleContext) error {
 		}
 	}
 
-	if r.requireLetters && !hasLetter {
-		return errors.New(passwordRuleErrLetter)
-	}
-	if r.requireMixed && (!hasUpper || !hasLower) {
-		return errors.New(passwordRuleErrMixed)
+	// Report every unmet requirement rather than only the first one
+	checks := []struct {
+		failed  bool
+		key     string
+		message string
+	}{
+		{r.requireLetters && !hasLetter, PasswordReasonLetters, passwordRuleErrLetter},
+		{r.requireMixed && (!hasUpper || !hasLower), PasswordReasonMixed, passwordRuleErrMixed},
+		{r.requireUpper && !hasUpper, PasswordReasonUppercase, passwordRuleErrUpper},
+		{r.requireLower && !hasLower, PasswordReasonLowercase, passwordRuleErrLower},
+		{r.requireNumbers && !hasNumber, PasswordReasonNumbers, passwordRuleErrNumber},
+		{r.requireSymbols && !hasSymbol, PasswordReasonSymbols, passwordRuleErrSymbol},
 	}
-	if r.requireUpper && !hasUpper {
-		return errors.New(passwordRuleErrUpper)
-	}
-	if r.requireLower && !hasLower {
-		return errors.New(passwordRuleErrLower)
-	}
-	if r.requireNumbers && !hasNumber {
-		return errors.New(passwordRuleErrNumber)
-	}
-	if r.requireSymbols && !hasSymbol {
-		return errors.New(passwordRuleErrSymbol)
+	for _, check := range checks {
+		if check.failed {
+			reasons = append(reasons, contract.NewReason(check.key, check.message, nil))
+		}
 	}
 
-	return nil
+	return contract.FailWith(reasons...)
 }
 
 func (r *PasswordRule) Name() string {
@@ -85,3 +85,30 @@ func TestPasswordRule_InvalidMinParam(t *testing.T) {
 		t.Error("expected error for invalid min parameter, got nil")
 	}
 }
+
+func TestPasswordRule_ReportsEveryUnmetRequirement(t *testing.T) {
+	rule, err := stringRule.NewPasswordRule([]string{"min:12", "mixedcase", "numbers", "symbols"})
+	if err != nil {
+		t.Fatalf("failed to create password rule: %v", err)
+	}
+
+	err = rule.Validate(contract.NewValidationContext("password", "short", nil, nil))
+	reasons := contract.ReasonsOf(err)
+	want := []string{
+		stringRule.PasswordReasonMin,
+		stringRule.PasswordReasonMixed,
+		stringRule.PasswordReasonNumbers,
+		stringRule.PasswordReasonSymbols,
+	}
+	if len(reasons) != len(want) {
+		t.Fatalf("expected %d reasons, got %v", len(want), reasons)
+	}
+	for i, key := range want {
+		if reasons[i].Key != key {
+			t.Errorf("reason %d: got %q, want %q", i, reasons[i].Key, key)
+		}
+	}
+	if reasons[0].Params["min"] != "12" {
+		t.Errorf("expected min parameter, got %v", reasons[0].Params)
+	}
+}
@@ -0,0 +1,42 @@
+package string
+
+import (
+	"errors"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/common"
+)
+
+const (
+	stringRuleName       = "string"
+	stringRuleDefaultMsg = "the :attribute must be a string"
+)
+
+// StringRule checks that a value is a string.
+type StringRule struct {
+	common.BaseRule
+}
+
+// NewStringRule creates an instance of StringRule.
+func NewStringRule() (contract.Rule, error) {
+	return &StringRule{
+		BaseRule: common.NewBaseRule(stringRuleName, stringRuleDefaultMsg, nil),
+	}, nil
+}
+
+// Validate checks that the value is a string.
+func (r *StringRule) Validate(ctx contract.RuleContext) error {
+	if r.ShouldSkipValidation(ctx.Value()) {
+		return nil
+	}
+
+	if _, ok := ctx.Value().(string); !ok {
+		return errors.New(stringRuleDefaultMsg)
+	}
+
+	return nil
+}
+
+func (r *StringRule) Name() string {
+	return stringRuleName
+}
@@ -0,0 +1,36 @@
+package string_test
+
+import (
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	stringrule "github.com/next-trace/scg-validator/rules/types/string"
+)
+
+func TestStringRule(t *testing.T) {
+	rule, err := stringrule.NewStringRule()
+	if err != nil {
+		t.Fatalf("Failed to create StringRule: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		value any
+		want  bool
+	}{
+		{"string", "text", true},
+		{"empty string", "", true},
+		{"integer", 123, false},
+		{"byte slice", []byte("text"), false},
+		{"nil input", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := rule.Validate(contract.NewValidationContext("field", tt.value, nil, nil))
+			if tt.want != (err == nil) {
+				t.Errorf("value %v: expected pass=%v, got error %v", tt.value, tt.want, err)
+			}
+		})
+	}
+}
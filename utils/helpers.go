@@ -1,105 +1,46 @@
 package utils
 
 import (
-	"errors"
 	"fmt"
 	"mime/multipart"
 	"net/textproto"
-	"reflect"
 	"strconv"
 	"strings"
-	"unicode/utf8"
 )
 
 // GetAsFloat converts various types to a float64 for size comparison.
 // For strings, it returns the rune count. For slices, arrays, and maps, it returns the length.
-// For numeric types, it returns the float64 value.
+// For numeric types, it returns the float64 value. Use GetAsSize to compare exactly.
 func GetAsFloat(value interface{}) (float64, error) {
-	if value == nil {
-		return 0, nil
+	n, err := GetAsSize(value)
+	if err != nil {
+		return 0, err
 	}
-
-	val := reflect.ValueOf(value)
-
-	switch val.Kind() {
-	case reflect.String:
-		return float64(utf8.RuneCountInString(val.String())), nil
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		return float64(val.Int()), nil
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		return float64(val.Uint()), nil
-	case reflect.Float32, reflect.Float64:
-		return val.Float(), nil
-	case reflect.Slice, reflect.Map, reflect.Array:
-		return float64(val.Len()), nil
-	}
-
-	return 0, fmt.Errorf("unsupported type for comparison: %T", value)
+	return n.Float64(), nil
 }
 
 // GetAsNumeric converts various types to a float64 for numeric comparison.
 // For strings, it attempts to parse them as numbers. For numeric types, it returns the float64 value.
-// This is different from GetAsFloat which returns string length for strings.
+// This is different from GetAsFloat which returns string length for strings. Use GetAsNumber to
+// compare exactly.
 func GetAsNumeric(value interface{}) (float64, error) {
-	if value == nil {
-		return 0, errors.New("cannot convert nil to numeric value")
-	}
-
-	val := reflect.ValueOf(value)
-
-	switch val.Kind() {
-	case reflect.String:
-		str := val.String()
-		if str == "" {
-			return 0, errors.New("cannot convert empty string to numeric value")
-		}
-		parsed, err := strconv.ParseFloat(str, 64)
-		if err != nil {
-			return 0, fmt.Errorf("cannot convert string '%s' to numeric value", str)
-		}
-		return parsed, nil
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		return float64(val.Int()), nil
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		return float64(val.Uint()), nil
-	case reflect.Float32, reflect.Float64:
-		return val.Float(), nil
+	n, err := GetAsNumber(value)
+	if err != nil {
+		return 0, err
 	}
-
-	return 0, fmt.Errorf("unsupported type for numeric comparison: %T", value)
+	return n.Float64(), nil
 }
 
 // GetAsComparable converts various types to a float64 for comparison rules.
 // It tries to parse strings as numbers first, but falls back to length if not numeric.
 // For collections (slices, maps, arrays), it returns the length.
-// For numeric types, it returns the numeric value.
+// For numeric types, it returns the numeric value. Use GetAsComparableNumber to compare exactly.
 func GetAsComparable(value interface{}) (float64, error) {
-	if value == nil {
-		return 0, errors.New("cannot convert nil to comparable value")
-	}
-
-	val := reflect.ValueOf(value)
-
-	switch val.Kind() {
-	case reflect.String:
-		str := val.String()
-		// Try to parse as number first
-		if parsed, err := strconv.ParseFloat(str, 64); err == nil {
-			return parsed, nil
-		}
-		// Fall back to string length (rune count)
-		return float64(utf8.RuneCountInString(str)), nil
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		return float64(val.Int()), nil
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		return float64(val.Uint()), nil
-	case reflect.Float32, reflect.Float64:
-		return val.Float(), nil
-	case reflect.Slice, reflect.Map, reflect.Array:
-		return float64(val.Len()), nil
+	n, err := GetAsComparableNumber(value)
+	if err != nil {
+		return 0, err
 	}
-
-	return 0, fmt.Errorf("unsupported type for comparison: %T", value)
+	return n.Float64(), nil
 }
 
 func ReplacePlaceholder(msg string, i int, param string) string {
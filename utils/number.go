@@ -0,0 +1,260 @@
+package utils
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// floatEqualityTolerance is the rounding error accepted on the quotient of a float and its factor
+const floatEqualityTolerance = 1e-9
+
+// Limits of the decimal strings ParseNumber accepts, which keep the cost of exact arithmetic on
+// untrusted input bounded
+const (
+	maxSignificantDigits = 100
+	maxDecimalExponent   = 1000
+)
+
+// Decimals String prints for fractions without a finite decimal expansion, and at most for others
+const (
+	repeatingFractionDigits = 16
+	maxFractionDigits       = 100
+)
+
+// scientificPrecision is the mantissa precision in bits that holds maxSignificantDigits digits
+const scientificPrecision = 340
+
+// Number is a numeric value compared exactly. Integers, decimal strings and json.Number values
+// are held as rationals; only values that were floats keep float64 semantics.
+// The zero value is the float 0.
+type Number struct {
+	exact *big.Rat
+	float float64
+}
+
+// NumberFromInt returns the exact Number of i
+func NumberFromInt(i int64) Number {
+	return Number{exact: new(big.Rat).SetInt64(i)}
+}
+
+// NumberFromFloat returns a Number keeping the float semantics of f
+func NumberFromFloat(f float64) Number {
+	return Number{float: f}
+}
+
+// ParseNumber parses a decimal string, optionally signed and with an exponent, into an exact Number.
+// Strings with more than 100 significant digits, or whose value needs a power of ten beyond
+// 10^±1000, are rejected.
+func ParseNumber(s string) (Number, error) {
+	if s == "" {
+		return Number{}, errors.New("cannot convert empty string to numeric value")
+	}
+	if !isDecimal(s) {
+		return Number{}, fmt.Errorf("cannot convert string '%s' to numeric value", s)
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return Number{}, fmt.Errorf("cannot convert string '%s' to numeric value", s)
+	}
+	return Number{exact: r}, nil
+}
+
+// isDecimal reports whether s is a decimal number within the limits of ParseNumber: an optional
+// sign, digits with an optional decimal point and an optional exponent
+func isDecimal(s string) bool {
+	if s != "" && (s[0] == '+' || s[0] == '-') {
+		s = s[1:]
+	}
+	exponent := 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		digits := strings.TrimLeft(s[i+1:], "+-")
+		e, err := strconv.Atoi(s[i+1:])
+		if err != nil || digits == "" || !isDigits(digits) {
+			return false
+		}
+		exponent, s = e, s[:i]
+	}
+	integer, fraction, _ := strings.Cut(s, ".")
+	if integer+fraction == "" || !isDigits(integer) || !isDigits(fraction) {
+		return false
+	}
+	// The value is the significant digits times 10^scale
+	scale := exponent - len(fraction)
+	significant := strings.TrimLeft(integer+fraction, "0")
+	return len(significant) <= maxSignificantDigits && scale >= -maxDecimalExponent && scale <= maxDecimalExponent
+}
+
+// isDigits reports whether s only holds ASCII digits
+func isDigits(s string) bool {
+	return strings.Trim(s, "0123456789") == ""
+}
+
+// IsFloat reports whether n compares with float semantics
+func (n Number) IsFloat() bool {
+	return n.exact == nil
+}
+
+// Float64 returns the nearest float64 to n
+func (n Number) Float64() float64 {
+	if n.exact == nil {
+		return n.float
+	}
+	f, _ := n.exact.Float64()
+	return f
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of n
+func (n Number) Sign() int {
+	if n.exact == nil {
+		return cmp.Compare(n.float, 0)
+	}
+	return n.exact.Sign()
+}
+
+// Cmp compares n and m and returns -1, 0 or +1. Two exact numbers compare exactly; when either is
+// a float both compare as float64.
+func (n Number) Cmp(m Number) int {
+	if n.exact != nil && m.exact != nil {
+		return n.exact.Cmp(m.exact)
+	}
+	return cmp.Compare(n.Float64(), m.Float64())
+}
+
+// IsMultipleOf reports whether n is an integer multiple of factor. Exact numbers are divided
+// exactly; floats tolerate a rounding error. Nothing is a multiple of zero.
+func (n Number) IsMultipleOf(factor Number) bool {
+	if factor.Sign() == 0 {
+		return false
+	}
+	if n.exact != nil && factor.exact != nil {
+		return new(big.Rat).Quo(n.exact, factor.exact).IsInt()
+	}
+	quotient := n.Float64() / factor.Float64()
+	return math.Abs(quotient-math.Round(quotient)) < floatEqualityTolerance
+}
+
+// String formats n in decimal notation without trailing zeros. Fractions without a finite
+// decimal expansion print 16 decimals, and fractions needing more than 100 decimals print in
+// scientific notation with up to 100 significant digits.
+func (n Number) String() string {
+	if n.exact == nil {
+		return strconv.FormatFloat(n.float, 'f', -1, 64)
+	}
+	if n.exact.IsInt() {
+		return n.exact.Num().String()
+	}
+	digits := fractionDigits(n.exact.Denom())
+	if digits > maxFractionDigits {
+		return new(big.Float).SetPrec(scientificPrecision).SetRat(n.exact).Text('g', maxSignificantDigits)
+	}
+	return n.exact.FloatString(digits)
+}
+
+// fractionDigits returns the number of decimals needed to print a fraction of denominator d.
+// The expansion is finite only when d is 2^a·5^b, and then has max(a, b) decimals.
+func fractionDigits(d *big.Int) int {
+	twos := d.TrailingZeroBits()
+	rest := new(big.Int).Rsh(d, twos)
+	// rest = 5^b has b·log2(5) bits, so b is one of two candidates
+	b := int(float64(rest.BitLen()-1) / math.Log2(5))
+	for _, fives := range []int{b, b + 1} {
+		if new(big.Int).Exp(big.NewInt(5), big.NewInt(int64(fives)), nil).Cmp(rest) == 0 {
+			return max(int(twos), fives)
+		}
+	}
+	return repeatingFractionDigits
+}
+
+// numberOf converts the numeric types, json.Number and the math/big types to a Number
+func numberOf(value interface{}) (Number, bool, error) {
+	switch v := value.(type) {
+	case json.Number:
+		n, err := ParseNumber(v.String())
+		return n, true, err
+	case *big.Int:
+		if v == nil {
+			return Number{}, true, errors.New("cannot convert nil to numeric value")
+		}
+		return Number{exact: new(big.Rat).SetInt(v)}, true, nil
+	case *big.Rat:
+		if v == nil {
+			return Number{}, true, errors.New("cannot convert nil to numeric value")
+		}
+		return Number{exact: new(big.Rat).Set(v)}, true, nil
+	}
+
+	val := reflect.ValueOf(value)
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return NumberFromInt(val.Int()), true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return Number{exact: new(big.Rat).SetInt(new(big.Int).SetUint64(val.Uint()))}, true, nil
+	case reflect.Float32, reflect.Float64:
+		f := val.Float()
+		if math.IsNaN(f) {
+			return Number{}, true, errors.New("cannot convert NaN to numeric value")
+		}
+		if val.Kind() == reflect.Float32 {
+			// Go through the shortest decimal form so that float32(0.1) stays 0.1
+			f, _ = strconv.ParseFloat(strconv.FormatFloat(f, 'g', -1, 32), 64)
+		}
+		return NumberFromFloat(f), true, nil
+	}
+	return Number{}, false, nil
+}
+
+// GetAsNumber converts a value to an exact Number for numeric comparison. Strings and
+// json.Number are parsed as decimals; only float32 and float64 values keep float semantics.
+func GetAsNumber(value interface{}) (Number, error) {
+	if value == nil {
+		return Number{}, errors.New("cannot convert nil to numeric value")
+	}
+	if n, ok, err := numberOf(value); ok {
+		return n, err
+	}
+	if val := reflect.ValueOf(value); val.Kind() == reflect.String {
+		return ParseNumber(val.String())
+	}
+	return Number{}, fmt.Errorf("unsupported type for numeric comparison: %T", value)
+}
+
+// GetAsSize is the exact form of GetAsFloat: strings measure their rune count, collections their
+// length and numbers their value
+func GetAsSize(value interface{}) (Number, error) {
+	if value == nil {
+		return NumberFromInt(0), nil
+	}
+	if n, ok, err := numberOf(value); ok {
+		return n, err
+	}
+	val := reflect.ValueOf(value)
+	switch val.Kind() {
+	case reflect.String:
+		return NumberFromInt(int64(utf8.RuneCountInString(val.String()))), nil
+	case reflect.Slice, reflect.Map, reflect.Array:
+		return NumberFromInt(int64(val.Len())), nil
+	}
+	return Number{}, fmt.Errorf("unsupported type for comparison: %T", value)
+}
+
+// GetAsComparableNumber is the exact form of GetAsComparable: numeric strings compare by value,
+// other strings by rune count and collections by length
+func GetAsComparableNumber(value interface{}) (Number, error) {
+	if value == nil {
+		return Number{}, errors.New("cannot convert nil to comparable value")
+	}
+	if val := reflect.ValueOf(value); val.Kind() == reflect.String {
+		if n, err := ParseNumber(val.String()); err == nil {
+			return n, nil
+		}
+	}
+	return GetAsSize(value)
+}
@@ -0,0 +1,156 @@
+package utils
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestParseNumber(t *testing.T) {
+	valid := map[string]string{
+		"12":                      "12",
+		"-0.50":                   "-0.5",
+		"+3.":                     "3",
+		".25":                     "0.25",
+		"1e3":                     "1000",
+		"2.5E-2":                  "0.025",
+		"12345678901234567890.01": "12345678901234567890.01",
+	}
+	for in, want := range valid {
+		n, err := ParseNumber(in)
+		if err != nil {
+			t.Fatalf("ParseNumber(%q) unexpected error: %v", in, err)
+		}
+		if n.IsFloat() || n.String() != want {
+			t.Fatalf("ParseNumber(%q) = %s (float %v), want exact %s", in, n, n.IsFloat(), want)
+		}
+	}
+
+	for _, in := range []string{
+		"", "abc", "1/3", "0x10", "1_000", "Inf", "NaN", "1e", "--1", ".", "1.2.3", " 1", "1e+-2",
+		"1e-100000", "1e1001", "0.001e-999", "1e99999999999999999999", strings.Repeat("9", 101),
+	} {
+		if _, err := ParseNumber(in); err == nil {
+			t.Fatalf("ParseNumber(%q) expected error", in)
+		}
+	}
+}
+
+func TestParseNumber_Limits(t *testing.T) {
+	for _, in := range []string{"1e1000", "1e-1000", strings.Repeat("9", 100), "0." + strings.Repeat("0", 99) + "1"} {
+		if _, err := ParseNumber(in); err != nil {
+			t.Fatalf("ParseNumber(%.20q...) unexpected error: %v", in, err)
+		}
+	}
+}
+
+func TestNumber_CmpIsExact(t *testing.T) {
+	above := int64(1)<<53 + 1
+	limit := NumberFromInt(1 << 53)
+	if NumberFromInt(above).Cmp(limit) <= 0 {
+		t.Fatal("expected 2^53+1 to be greater than 2^53")
+	}
+	if float64(above) > float64(1<<53) {
+		t.Fatal("float64 precision assumption no longer holds")
+	}
+
+	a, _ := GetAsNumber("0.30000000000000001")
+	b, _ := GetAsNumber(json.Number("0.3"))
+	if a.Cmp(b) <= 0 {
+		t.Fatal("expected decimal strings to compare exactly")
+	}
+
+	huge, _ := GetAsNumber(uint64(math.MaxUint64))
+	if huge.Cmp(NumberFromInt(math.MaxInt64)) <= 0 || huge.String() != "18446744073709551615" {
+		t.Fatalf("unexpected uint64 number %s", huge)
+	}
+
+	f, _ := GetAsNumber(0.1)
+	if !f.IsFloat() || f.Cmp(NumberFromFloat(0.1)) != 0 {
+		t.Fatal("expected floats to keep float semantics")
+	}
+	f32, _ := GetAsNumber(float32(0.1))
+	if f32.Cmp(f) != 0 {
+		t.Fatalf("expected float32(0.1) to equal 0.1, got %s", f32)
+	}
+}
+
+func TestNumber_IsMultipleOf(t *testing.T) {
+	tenth, _ := ParseNumber("0.1")
+	cases := []struct {
+		in   interface{}
+		want bool
+	}{
+		{"0.3", true},
+		{"0.35", false},
+		{json.Number("19.9"), true},
+		{0.3, true}, // float, within tolerance
+		{7, true},
+		{"1e-2", false},
+		{big.NewInt(5), true},
+	}
+	for _, c := range cases {
+		n, err := GetAsNumber(c.in)
+		if err != nil {
+			t.Fatalf("GetAsNumber(%v) unexpected error: %v", c.in, err)
+		}
+		if got := n.IsMultipleOf(tenth); got != c.want {
+			t.Fatalf("%v multiple of 0.1 = %v, want %v", c.in, got, c.want)
+		}
+	}
+	if NumberFromInt(4).IsMultipleOf(NumberFromInt(0)) {
+		t.Fatal("nothing is a multiple of zero")
+	}
+}
+
+func TestNumber_String(t *testing.T) {
+	third := Number{exact: big.NewRat(1, 3)}
+	tiny := new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Exp(big.NewInt(10), big.NewInt(1000), nil))
+	cases := map[string]Number{
+		"0.125":              {exact: big.NewRat(1, 8)},
+		"-42":                NumberFromInt(-42),
+		"0.3333333333333333": third,
+		"1.5":                NumberFromFloat(1.5),
+		"1e-1000":            {exact: tiny},
+	}
+	for want, n := range cases {
+		if got := n.String(); got != want {
+			t.Fatalf("String() = %q, want %q", got, want)
+		}
+	}
+}
+
+func TestGetAsSizeAndComparableNumber(t *testing.T) {
+	cases := []struct {
+		in         interface{}
+		size       string
+		comparable string
+	}{
+		{"12.50", "5", "12.5"},
+		{"héllo", "5", "5"},
+		{json.Number("9007199254740993"), "9007199254740993", "9007199254740993"},
+		{[]int{1, 2}, "2", "2"},
+		{int64(-7), "-7", "-7"},
+	}
+	for _, c := range cases {
+		size, err := GetAsSize(c.in)
+		if err != nil || size.String() != c.size {
+			t.Fatalf("GetAsSize(%v) = %s, %v; want %s", c.in, size, err, c.size)
+		}
+		comparable, err := GetAsComparableNumber(c.in)
+		if err != nil || comparable.String() != c.comparable {
+			t.Fatalf("GetAsComparableNumber(%v) = %s, %v; want %s", c.in, comparable, err, c.comparable)
+		}
+	}
+
+	for _, in := range []interface{}{math.NaN(), json.Number("x"), struct{}{}} {
+		if _, err := GetAsSize(in); err == nil {
+			t.Fatalf("GetAsSize(%v) expected error", in)
+		}
+	}
+	if _, err := GetAsComparableNumber(nil); err == nil {
+		t.Fatal("GetAsComparableNumber(nil) expected error")
+	}
+}
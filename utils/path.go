@@ -0,0 +1,227 @@
+package utils
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Field path syntax used by rule keys and cross-field parameters
+const (
+	PathSeparator = "."
+	PathWildcard  = "*"
+)
+
+// GetPath retrieves a value from nested data using dot notation, e.g. "address.city" or
+// "items.0.price". A top-level key that matches the whole path takes precedence, so flat keys
+// containing dots keep working.
+func GetPath(data map[string]any, path string) (any, bool) {
+	if data == nil {
+		return nil, false
+	}
+	if value, exists := data[path]; exists {
+		return value, true
+	}
+	if !strings.Contains(path, PathSeparator) {
+		return nil, false
+	}
+
+	var current any = data
+	for _, segment := range strings.Split(path, PathSeparator) {
+		next, exists := childValue(current, segment)
+		if !exists {
+			return nil, false
+		}
+		current = next
+	}
+	return current, true
+}
+
+// HasPath reports whether the given dot-notation path exists in data
+func HasPath(data map[string]any, path string) bool {
+	_, exists := GetPath(data, path)
+	return exists
+}
+
+// HasWildcard reports whether a field path contains a "*" segment
+func HasWildcard(path string) bool {
+	for _, segment := range strings.Split(path, PathSeparator) {
+		if segment == PathWildcard {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpandPath expands every "*" segment of pattern against data and returns the concrete
+// paths that exist, e.g. "items.*.price" becomes ["items.0.price", "items.1.price"].
+// Slice elements are visited in index order and map keys in sorted order.
+// The last segment does not need to exist so that rules such as required can report it.
+func ExpandPath(data map[string]any, pattern string) []string {
+	if !HasWildcard(pattern) {
+		return []string{pattern}
+	}
+
+	segments := strings.Split(pattern, PathSeparator)
+	var paths []string
+	expandSegments(data, segments, "", &paths)
+	return paths
+}
+
+// expandSegments walks the remaining segments below value, collecting concrete paths
+func expandSegments(value any, segments []string, prefix string, paths *[]string) {
+	if len(segments) == 0 {
+		*paths = append(*paths, prefix)
+		return
+	}
+
+	segment := segments[0]
+	if segment != PathWildcard {
+		if len(segments) == 1 {
+			*paths = append(*paths, joinPath(prefix, segment))
+			return
+		}
+		next, exists := childValue(value, segment)
+		if !exists {
+			return
+		}
+		expandSegments(next, segments[1:], joinPath(prefix, segment), paths)
+		return
+	}
+
+	for _, key := range childKeys(value) {
+		next, _ := childValue(value, key)
+		expandSegments(next, segments[1:], joinPath(prefix, key), paths)
+	}
+}
+
+// WildcardIndices returns the segments of path that were matched by "*" in pattern,
+// e.g. pattern "items.*.tags.*" and path "items.2.tags.0" yield ["2", "0"].
+func WildcardIndices(pattern, path string) []string {
+	patternSegments := strings.Split(pattern, PathSeparator)
+	pathSegments := strings.Split(path, PathSeparator)
+	if len(patternSegments) != len(pathSegments) {
+		return nil
+	}
+
+	var indices []string
+	for i, segment := range patternSegments {
+		if segment == PathWildcard {
+			indices = append(indices, pathSegments[i])
+		}
+	}
+	return indices
+}
+
+// MatchPath reports whether path matches pattern segment by segment, where a "*" segment
+// matches any single segment, e.g. "items.*.price" matches "items.3.price".
+func MatchPath(pattern, path string) bool {
+	patternSegments := strings.Split(pattern, PathSeparator)
+	pathSegments := strings.Split(path, PathSeparator)
+	if len(patternSegments) != len(pathSegments) {
+		return false
+	}
+
+	for i, segment := range patternSegments {
+		if segment != PathWildcard && segment != pathSegments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ReplaceWildcards substitutes "*" segments in path with the given indices, in order.
+// It is used to resolve cross-field parameters such as "items.*.type" relative to the
+// element being validated. Segments beyond the available indices are left untouched.
+func ReplaceWildcards(path string, indices []string) string {
+	if len(indices) == 0 || !HasWildcard(path) {
+		return path
+	}
+
+	segments := strings.Split(path, PathSeparator)
+	next := 0
+	for i, segment := range segments {
+		if segment == PathWildcard && next < len(indices) {
+			segments[i] = indices[next]
+			next++
+		}
+	}
+	return strings.Join(segments, PathSeparator)
+}
+
+// childValue returns the value stored under key in a map, slice or array
+func childValue(container any, key string) (any, bool) {
+	if m, ok := container.(map[string]any); ok {
+		value, exists := m[key]
+		return value, exists
+	}
+
+	val := reflect.ValueOf(container)
+	for val.IsValid() && (val.Kind() == reflect.Ptr || val.Kind() == reflect.Interface) {
+		if val.IsNil() {
+			return nil, false
+		}
+		val = val.Elem()
+	}
+
+	switch val.Kind() {
+	case reflect.Map:
+		if val.Type().Key().Kind() != reflect.String {
+			return nil, false
+		}
+		item := val.MapIndex(reflect.ValueOf(key).Convert(val.Type().Key()))
+		if !item.IsValid() {
+			return nil, false
+		}
+		return item.Interface(), true
+	case reflect.Slice, reflect.Array:
+		index, err := strconv.Atoi(key)
+		if err != nil || index < 0 || index >= val.Len() {
+			return nil, false
+		}
+		return val.Index(index).Interface(), true
+	}
+
+	return nil, false
+}
+
+// childKeys lists the keys of a map (sorted) or the indices of a slice or array
+func childKeys(container any) []string {
+	val := reflect.ValueOf(container)
+	for val.IsValid() && (val.Kind() == reflect.Ptr || val.Kind() == reflect.Interface) {
+		if val.IsNil() {
+			return nil
+		}
+		val = val.Elem()
+	}
+
+	switch val.Kind() {
+	case reflect.Map:
+		if val.Type().Key().Kind() != reflect.String {
+			return nil
+		}
+		keys := make([]string, 0, val.Len())
+		for _, key := range val.MapKeys() {
+			keys = append(keys, key.String())
+		}
+		sort.Strings(keys)
+		return keys
+	case reflect.Slice, reflect.Array:
+		keys := make([]string, val.Len())
+		for i := range keys {
+			keys[i] = strconv.Itoa(i)
+		}
+		return keys
+	}
+
+	return nil
+}
+
+// joinPath joins a path prefix and segment with the path separator
+func joinPath(prefix, segment string) string {
+	if prefix == "" {
+		return segment
+	}
+	return prefix + PathSeparator + segment
+}
@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func pathTestData() map[string]any {
+	return map[string]any{
+		"flat.key": "flat",
+		"address":  map[string]any{"city": "Berlin"},
+		"items": []any{
+			map[string]any{"price": 10, "tags": []string{"a", "b"}},
+			map[string]any{"price": 20},
+		},
+		"scores": map[string]int{"b": 2, "a": 1},
+	}
+}
+
+func TestGetPath(t *testing.T) {
+	data := pathTestData()
+	cases := []struct {
+		path   string
+		want   any
+		exists bool
+	}{
+		{"flat.key", "flat", true},
+		{"address.city", "Berlin", true},
+		{"address.zip", nil, false},
+		{"items.1.price", 20, true},
+		{"items.0.tags.1", "b", true},
+		{"items.5.price", nil, false},
+		{"scores.a", 1, true},
+		{"missing", nil, false},
+	}
+	for _, c := range cases {
+		got, ok := GetPath(data, c.path)
+		if ok != c.exists || (ok && !reflect.DeepEqual(got, c.want)) {
+			t.Fatalf("GetPath(%q) = %v, %v; want %v, %v", c.path, got, ok, c.want, c.exists)
+		}
+	}
+	if _, ok := GetPath(nil, "a"); ok {
+		t.Fatal("expected nil data to have no paths")
+	}
+}
+
+func TestExpandPath(t *testing.T) {
+	data := pathTestData()
+	cases := []struct {
+		pattern string
+		want    []string
+	}{
+		{"address.city", []string{"address.city"}},
+		{"items.*.price", []string{"items.0.price", "items.1.price"}},
+		{"items.*.tags.*", []string{"items.0.tags.0", "items.0.tags.1"}},
+		{"scores.*", []string{"scores.a", "scores.b"}},
+		{"missing.*.x", nil},
+	}
+	for _, c := range cases {
+		if got := ExpandPath(data, c.pattern); !reflect.DeepEqual(got, c.want) {
+			t.Fatalf("ExpandPath(%q) = %v, want %v", c.pattern, got, c.want)
+		}
+	}
+}
+
+func TestWildcardIndicesAndReplace(t *testing.T) {
+	indices := WildcardIndices("items.*.tags.*", "items.2.tags.0")
+	if !reflect.DeepEqual(indices, []string{"2", "0"}) {
+		t.Fatalf("unexpected indices: %v", indices)
+	}
+	if got := ReplaceWildcards("items.*.type", indices); got != "items.2.type" {
+		t.Fatalf("unexpected replacement: %q", got)
+	}
+	// non-segment asterisks such as regex patterns are left untouched
+	if got := ReplaceWildcards("/^a.*b$/", indices); got != "/^a.*b$/" {
+		t.Fatalf("unexpected replacement of regex: %q", got)
+	}
+}
+
+func TestMatchPath(t *testing.T) {
+	cases := []struct {
+		pattern, path string
+		want          bool
+	}{
+		{"items.*.price", "items.3.price", true},
+		{"items.*.price", "items.3.name", false},
+		{"items.*", "items.3.price", false},
+		{"*.*", "a.b", true},
+		{"name", "name", true},
+	}
+	for _, c := range cases {
+		if got := MatchPath(c.pattern, c.path); got != c.want {
+			t.Errorf("MatchPath(%q, %q) = %v, want %v", c.pattern, c.path, got, c.want)
+		}
+	}
+}
@@ -1,84 +1,269 @@
 package utils
 
 import (
+	"errors"
+	"fmt"
+	"sort"
 	"strings"
+	"sync"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/next-trace/scg-validator/contract"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
 )
 
-// TranslateError converts internal validation errors into a simple, user-friendly
-// map of field -> message. If err is not a validation error, it returns an empty map.
-//
-// Rules:
-//   - If the rule corresponds to a required-type failure, the message becomes
-//     "This field is required".
-//   - Otherwise, we attempt to humanize the message by replacing ":attribute" with
-//     "This field", capitalizing the sentence, and ensuring it ends with a period.
-func TranslateError(err error) map[string]string {
-	res := make(map[string]string)
-	if err == nil {
-		return res
+// attributePlaceholder is the placeholder unrendered messages refer to the field by
+const attributePlaceholder = ":attribute"
+
+// TranslateStrategy selects which failures of a field are translated
+type TranslateStrategy int
+
+const (
+	// FirstErrorStrategy keeps the first message of each field
+	FirstErrorStrategy TranslateStrategy = iota
+	// AllErrorsStrategy keeps every message of each field, in the order they were recorded
+	AllErrorsStrategy
+)
+
+// Wording selects how translated messages refer to the field
+type Wording int
+
+const (
+	// NeutralWording refers to the field with the neutral phrase of the sentence rules, e.g. "This field"
+	NeutralWording Wording = iota
+	// AttributeWording keeps the attribute name, e.g. "The email address"
+	AttributeWording
+)
+
+// SentenceRules describe how the messages of a language become user-facing sentences
+type SentenceRules struct {
+	// Subjects are the phrases built around the attribute name, "%s" standing for it, most
+	// specific first, e.g. "the %s field" and "the %s". Under NeutralWording the first one found
+	// in the message template, as written or capitalized, is replaced by Neutral.
+	Subjects []string
+	// Neutral refers to the field under NeutralWording, e.g. "this field"
+	Neutral string
+	// Terminator is appended to sentences that do not end with one of TerminalMarks, e.g. "."
+	Terminator string
+	// TerminalMarks lists the characters that end a sentence, e.g. ".!?"
+	TerminalMarks string
+	// Capitalize upper-cases the first letter of each sentence using the case rules of the language
+	Capitalize bool
+}
+
+// englishSentenceRules are used for English and for locales without rules of their own
+var englishSentenceRules = SentenceRules{
+	Subjects:      []string{"the %s field", "the %s", "%s"},
+	Neutral:       "this field",
+	Terminator:    ".",
+	TerminalMarks: ".!?",
+	Capitalize:    true,
+}
+
+var (
+	sentenceRulesMu sync.RWMutex
+	sentenceRules   = map[language.Tag]SentenceRules{language.English: englishSentenceRules}
+)
+
+// RegisterSentenceRules sets the sentence rules used to translate messages of the locale tag
+func RegisterSentenceRules(tag language.Tag, rules SentenceRules) {
+	sentenceRulesMu.Lock()
+	defer sentenceRulesMu.Unlock()
+	sentenceRules[tag] = rules
+}
+
+// SentenceRulesFor returns the sentence rules of tag, or of its closest registered parent
+// locale, falling back to English
+func SentenceRulesFor(tag language.Tag) SentenceRules {
+	sentenceRulesMu.RLock()
+	defer sentenceRulesMu.RUnlock()
+	for t := tag; ; t = t.Parent() {
+		if rules, ok := sentenceRules[t]; ok {
+			return rules
+		}
+		if t.IsRoot() {
+			return englishSentenceRules
+		}
 	}
+}
 
-	// Prefer concrete type assertion
-	if ve, ok := err.(*contract.ValidationErrors); ok {
-		return translateFromMap(ve.Errors())
+// translateConfig holds the options of a translation
+type translateConfig struct {
+	strategy TranslateStrategy
+	wording  Wording
+	locale   language.Tag
+	rules    *SentenceRules
+}
+
+// TranslateOption configures TranslateError and TranslateErrors
+type TranslateOption func(*translateConfig)
+
+// WithStrategy selects whether the first or every message of a field is translated
+func WithStrategy(strategy TranslateStrategy) TranslateOption {
+	return func(c *translateConfig) {
+		c.strategy = strategy
 	}
+}
 
-	// Fallback: support any error that exposes Errors() map[string][]string
-	type errorMap interface{ Errors() map[string][]string }
-	if em, ok := err.(errorMap); ok {
-		return translateFromMap(em.Errors())
+// WithWording selects whether messages refer to the field neutrally or by its attribute name
+func WithWording(wording Wording) TranslateOption {
+	return func(c *translateConfig) {
+		c.wording = wording
 	}
+}
 
-	return res
+// WithLocale sets the language of the messages, which selects the sentence rules and the
+// case rules used for capitalization
+func WithLocale(tag language.Tag) TranslateOption {
+	return func(c *translateConfig) {
+		c.locale = tag
+	}
 }
 
-func translateFromMap(m map[string][]string) map[string]string {
-	out := make(map[string]string, len(m))
-	for field, msgs := range m {
-		if len(msgs) == 0 {
+// WithSentenceRules overrides the sentence rules registered for the locale
+func WithSentenceRules(rules SentenceRules) TranslateOption {
+	return func(c *translateConfig) {
+		c.rules = &rules
+	}
+}
+
+// TranslateError converts validation errors into a simple, user-friendly map of
+// field -> message. If err does not carry validation errors, it returns an empty map.
+//
+// By default the first failure of each field is kept and the field is referred to neutrally:
+// the message is rendered again from the template recorded on each failure, with "This field"
+// as its subject, and the sentence is capitalized and ends with a period. Under
+// AllErrorsStrategy the sentences of a field are joined with a space. Only the :attribute
+// placeholder of the template is reworded, so wording such as "required when status is
+// active" is kept intact. Failures without a template keep their message.
+func TranslateError(err error, options ...TranslateOption) map[string]string {
+	translated := TranslateErrors(err, options...)
+	out := make(map[string]string, len(translated))
+	for field, sentences := range translated {
+		out[field] = strings.Join(sentences, " ")
+	}
+	return out
+}
+
+// TranslateErrors is TranslateError keeping the sentences of a field apart
+func TranslateErrors(err error, options ...TranslateOption) map[string][]string {
+	config := translateConfig{locale: language.English}
+	for _, option := range options {
+		option(&config)
+	}
+	rules := SentenceRulesFor(config.locale)
+	if config.rules != nil {
+		rules = *config.rules
+	}
+	caser := cases.Upper(config.locale)
+
+	out := make(map[string][]string)
+	for _, failure := range failuresOf(err) {
+		if config.strategy == FirstErrorStrategy && len(out[failure.Field]) > 0 {
 			continue
 		}
-		msg := msgs[0]
-		out[field] = humanizeMessage(msg)
+		sentence := translateFailure(failure, config.wording, rules, caser)
+		if sentence != "" {
+			out[failure.Field] = append(out[failure.Field], sentence)
+		}
 	}
 	return out
 }
 
-func humanizeMessage(msg string) string {
-	s := strings.TrimSpace(msg)
+// failuresOf extracts the structured failures of err. Errors exposing only a message map are
+// converted in field order, without rule details.
+func failuresOf(err error) []contract.FieldFailure {
+	if err == nil {
+		return nil
+	}
+
+	type failureLister interface {
+		Failures() []contract.FieldFailure
+	}
+	var fl failureLister
+	if errors.As(err, &fl) {
+		return fl.Failures()
+	}
+
+	type errorMap interface{ Errors() map[string][]string }
+	var em errorMap
+	if !errors.As(err, &em) {
+		return nil
+	}
+	messages := em.Errors()
+	fields := make([]string, 0, len(messages))
+	for field := range messages {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	var failures []contract.FieldFailure
+	for _, field := range fields {
+		for _, msg := range messages[field] {
+			failures = append(failures, contract.FieldFailure{Field: field, Message: msg})
+		}
+	}
+	return failures
+}
+
+// translateFailure turns the message of a failure into a sentence
+func translateFailure(failure contract.FieldFailure, wording Wording, rules SentenceRules, caser cases.Caser) string {
+	s := strings.TrimSpace(failure.Message)
 	if s == "" {
 		return s
 	}
 
-	low := strings.ToLower(s)
-	// Handle any flavor of "required" as a simple, clear phrase.
-	if strings.Contains(low, " required") || strings.HasPrefix(low, "required") {
-		return "This field is required"
+	template := strings.TrimSpace(failure.Template)
+	if template == "" {
+		// Messages recorded without a resolver still hold the placeholder
+		template = s
 	}
+	if strings.Contains(template, attributePlaceholder) {
+		switch wording {
+		case NeutralWording:
+			s = neutralize(template, rules, caser)
+		case AttributeWording:
+			name := failure.Attribute
+			if name == "" {
+				name = failure.Field
+			}
+			s = strings.ReplaceAll(template, attributePlaceholder, name)
+		}
+	}
+	return sentence(s, rules, caser)
+}
 
-	// Replace attribute placeholder with neutral wording.
-	s = strings.ReplaceAll(s, ":attribute", "This field")
-	// Common leading pattern from rule messages: "the :attribute ..."
-	lowS := strings.ToLower(s)
-	if strings.HasPrefix(lowS, "the this field ") {
-		// Normalize to start with "This field "
-		// len("the this field ") == 15
-		s = "This field " + s[15:]
+// neutralize renders a template with the neutral phrase as its subject: the first subject
+// phrase around the placeholder is replaced as a whole, and the placeholder alone otherwise
+func neutralize(template string, rules SentenceRules, caser cases.Caser) string {
+	for _, subject := range rules.Subjects {
+		phrase := fmt.Sprintf(subject, attributePlaceholder)
+		for _, candidate := range []string{phrase, capitalize(phrase, caser)} {
+			if strings.Contains(template, candidate) {
+				return strings.Replace(template, candidate, rules.Neutral, 1)
+			}
+		}
 	}
+	return strings.ReplaceAll(template, attributePlaceholder, rules.Neutral)
+}
 
-	// Ensure sentence starts with uppercase
-	runes := []rune(s)
-	if len(runes) > 0 {
-		runes[0] = unicode.ToUpper(runes[0])
-		s = string(runes)
+// capitalize upper-cases the first letter of s
+func capitalize(s string, caser cases.Caser) string {
+	if first, size := utf8.DecodeRuneInString(s); unicode.IsLower(first) {
+		return caser.String(s[:size]) + s[size:]
 	}
+	return s
+}
 
-	// Ensure sentence ends with a period if it has no terminal punctuation
-	if !strings.HasSuffix(s, ".") && !strings.HasSuffix(s, "!") && !strings.HasSuffix(s, "?") {
-		s += "."
+// sentence capitalizes s and adds the terminator when it has no terminal punctuation
+func sentence(s string, rules SentenceRules, caser cases.Caser) string {
+	if rules.Capitalize {
+		s = capitalize(s, caser)
+	}
+	if last, _ := utf8.DecodeLastRuneInString(s); !strings.ContainsRune(rules.TerminalMarks, last) {
+		s += rules.Terminator
 	}
 	return s
 }
@@ -1,9 +1,11 @@
 package utils
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/next-trace/scg-validator/contract"
+	"golang.org/x/text/language"
 )
 
 func TestTranslateError_Nil(t *testing.T) {
@@ -18,8 +20,8 @@ func TestTranslateError_Required(t *testing.T) {
 	ve.AddError("name", "the :attribute field is required")
 
 	got := TranslateError(ve)
-	if got["name"] != "This field is required" {
-		t.Fatalf("expected 'This field is required', got %q", got["name"])
+	if got["name"] != "This field is required." {
+		t.Fatalf("expected 'This field is required.', got %q", got["name"])
 	}
 }
 
@@ -45,3 +47,112 @@ func TestTranslateError_TakesFirstErrorPerField(t *testing.T) {
 		t.Fatalf("expected %q, got %q", expected, got["age"])
 	}
 }
+
+func TestTranslateError_KeepsConditionalWording(t *testing.T) {
+	ve := contract.NewValidationErrors()
+	ve.AddFailure(contract.FieldFailure{
+		Field: "phone", Rule: "required_if", Attribute: "phone number",
+		Message:  "The phone number field is required when status is active",
+		Template: "The :attribute field is required when status is active",
+	})
+	ve.AddFailure(contract.FieldFailure{
+		Field: "email", Rule: "email", Attribute: "email",
+		Message:  "The email must be a valid email address",
+		Template: "The :attribute must be a valid email address",
+	})
+	ve.AddFailure(contract.FieldFailure{
+		Field: "legacy", Rule: "custom", Attribute: "legacy",
+		Message: "The legacy value is outdated",
+	})
+
+	got := TranslateError(ve)
+	if want := "This field is required when status is active."; got["phone"] != want {
+		t.Errorf("expected %q, got %q", want, got["phone"])
+	}
+	// Only the subject is replaced, not later mentions of the attribute name
+	if want := "This field must be a valid email address."; got["email"] != want {
+		t.Errorf("expected %q, got %q", want, got["email"])
+	}
+	// Messages without a template are not reworded
+	if want := "The legacy value is outdated."; got["legacy"] != want {
+		t.Errorf("expected %q, got %q", want, got["legacy"])
+	}
+}
+
+func TestTranslateErrors_StrategiesAndWording(t *testing.T) {
+	ve := contract.NewValidationErrors()
+	ve.AddFailure(contract.FieldFailure{
+		Field: "age", Attribute: "age",
+		Message: "The age must be a number", Template: "The :attribute must be a number",
+	})
+	ve.AddFailure(contract.FieldFailure{
+		Field: "age", Attribute: "age",
+		Message: "The age must be at least 18", Template: "The :attribute must be at least 18",
+	})
+
+	got := TranslateErrors(ve, WithStrategy(AllErrorsStrategy), WithWording(AttributeWording))
+	want := []string{"The age must be a number.", "The age must be at least 18."}
+	if !reflect.DeepEqual(got["age"], want) {
+		t.Errorf("expected %q, got %q", want, got["age"])
+	}
+
+	joined := TranslateError(ve, WithStrategy(AllErrorsStrategy))
+	if joined["age"] != "This field must be a number. This field must be at least 18." {
+		t.Errorf("unexpected joined sentences: %q", joined["age"])
+	}
+}
+
+// restoreSentenceRules restores the sentence rules registered for tag when the test ends
+func restoreSentenceRules(t *testing.T, tag language.Tag) {
+	t.Helper()
+	sentenceRulesMu.RLock()
+	previous, registered := sentenceRules[tag]
+	sentenceRulesMu.RUnlock()
+	t.Cleanup(func() {
+		sentenceRulesMu.Lock()
+		defer sentenceRulesMu.Unlock()
+		if registered {
+			sentenceRules[tag] = previous
+		} else {
+			delete(sentenceRules, tag)
+		}
+	})
+}
+
+func TestTranslateError_LocaleRules(t *testing.T) {
+	restoreSentenceRules(t, language.Turkish)
+	RegisterSentenceRules(language.Turkish, SentenceRules{
+		Subjects:      []string{"%s alanı"},
+		Neutral:       "bu alan",
+		Terminator:    ".",
+		TerminalMarks: ".!?",
+		Capitalize:    true,
+	})
+	ve := contract.NewValidationErrors()
+	ve.AddFailure(contract.FieldFailure{
+		Field: "ad", Attribute: "isim", Message: "isim alanı zorunludur", Template: ":attribute alanı zorunludur",
+	})
+
+	// Turkish case rules capitalize the dotted i
+	got := TranslateError(ve, WithWording(AttributeWording), WithLocale(language.Turkish))
+	if got["ad"] != "İsim alanı zorunludur." {
+		t.Errorf("unexpected Turkish capitalization: %q", got["ad"])
+	}
+	got = TranslateError(ve, WithLocale(language.MustParse("tr-CY")))
+	if got["ad"] != "Bu alan zorunludur." {
+		t.Errorf("unexpected Turkish neutral wording: %q", got["ad"])
+	}
+
+	// Languages without capitals or with other punctuation use their own rules
+	ja := SentenceRules{
+		Subjects: []string{"%s"}, Neutral: "この項目", Terminator: "。", TerminalMarks: "。！？",
+	}
+	ve = contract.NewValidationErrors()
+	ve.AddFailure(contract.FieldFailure{
+		Field: "name", Attribute: "名前", Message: "名前は必須です", Template: ":attributeは必須です",
+	})
+	got = TranslateError(ve, WithLocale(language.Japanese), WithSentenceRules(ja))
+	if got["name"] != "この項目は必須です。" {
+		t.Errorf("unexpected Japanese sentence: %q", got["name"])
+	}
+}
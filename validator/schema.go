@@ -0,0 +1,70 @@
+package validator
+
+import (
+	"context"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/engine"
+)
+
+// Schema is a compiled set of rules: rule strings are parsed and rule objects created once,
+// so validating against a Schema skips both steps. A Schema is safe for concurrent use.
+type Schema struct {
+	engine contract.ValidationEngine
+	rules  contract.CompiledRules
+}
+
+// Compile compiles rules with a default validator. Unknown rules and invalid rule parameters
+// are reported here, wrapping contract.ErrRuleNotFound or contract.ErrInvalidRule, instead of
+// as field errors during validation.
+func Compile(rules map[string]string) (*Schema, error) {
+	return New().Compile(rules)
+}
+
+// Compile compiles rules using the validator's rule registry and messages.
+// Fields are validated in sorted order, as with ValidateWithResult.
+func (v *Validator) Compile(rules map[string]string) (*Schema, error) {
+	return v.CompileOrdered(contract.SortedFieldRules(rules))
+}
+
+// CompileOrdered compiles an ordered rules input; fields are validated in the given order.
+// The schema keeps the custom messages and attributes the validator has when it is compiled.
+func (v *Validator) CompileOrdered(rules []contract.FieldRules) (*Schema, error) {
+	snapshot := v.createRequestScopedEngine()
+	compiled, err := snapshot.Compile(rules)
+	if err != nil {
+		return nil, err
+	}
+	return &Schema{engine: snapshot, rules: compiled}, nil
+}
+
+// Fields returns the compiled field patterns in validation order
+func (s *Schema) Fields() []string {
+	return s.rules.Fields()
+}
+
+// Validate validates data against the schema and returns the full result.
+// Struct data is converted to a map keyed by JSON field name; its `validate` tags are ignored
+// because the schema already defines the rules.
+func (s *Schema) Validate(data any) contract.Result {
+	result, _ := s.ValidateContext(context.Background(), data)
+	return result
+}
+
+// ValidateContext is like Validate but passes ctx to rules and verifiers.
+// It returns a non-nil error only when validation stopped early because ctx is done.
+func (s *Schema) ValidateContext(ctx context.Context, data any) (contract.Result, error) {
+	var dataMap map[string]any
+	switch d := data.(type) {
+	case map[string]any:
+		dataMap = d
+	default:
+		if isStructData(d) {
+			dataMap = extractStructData(d).data
+		} else {
+			dataMap = make(map[string]any)
+		}
+	}
+
+	return s.engine.ExecuteCompiled(ctx, engine.NewDataProvider(dataMap), s.rules)
+}
@@ -0,0 +1,158 @@
+package validator
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+var benchmarkRules = map[string]string{
+	"name":          "required|alpha|min:3|max:50",
+	"email":         "required|email",
+	"age":           "required|integer|between:18,120",
+	"items.*.price": "required_if:items.*.type,digital|numeric|gt:0",
+}
+
+var benchmarkData = map[string]any{
+	"name":  "John",
+	"email": "john@example.com",
+	"age":   30,
+	"items": []any{
+		map[string]any{"type": "digital", "price": 5},
+		map[string]any{"type": "physical", "price": 10},
+	},
+}
+
+func TestCompile_Validate(t *testing.T) {
+	schema, err := Compile(benchmarkRules)
+	if err != nil {
+		t.Fatalf("unexpected compile error: %v", err)
+	}
+
+	if res := schema.Validate(benchmarkData); !res.IsValid() {
+		t.Fatalf("expected valid data, got %v", res.Errors())
+	}
+
+	res := schema.Validate(map[string]any{
+		"name":  "Jo",
+		"email": "bad",
+		"age":   30,
+		"items": []any{map[string]any{"type": "digital"}, map[string]any{"type": "physical"}},
+	})
+	for _, field := range []string{"name", "email", "items.0.price"} {
+		if !res.HasFieldError(field) {
+			t.Errorf("expected error for %s, got %v", field, res.Errors())
+		}
+	}
+	if res.HasFieldError("items.1.price") {
+		t.Errorf("did not expect error for items.1.price: %v", res.Errors())
+	}
+}
+
+func TestCompile_ReportsRuleErrors(t *testing.T) {
+	_, err := Compile(map[string]string{"field": "nonexistent"})
+	if !errors.Is(err, contract.ErrRuleNotFound) {
+		t.Fatalf("expected rule not found error, got %v", err)
+	}
+
+	_, err = Compile(map[string]string{"tos": "accepted_if:status"})
+	if !errors.Is(err, contract.ErrInvalidRule) {
+		t.Fatalf("expected invalid rule error, got %v", err)
+	}
+}
+
+func TestCompileOrdered_CustomMessagesAndOrder(t *testing.T) {
+	v := New()
+	v.SetCustomMessage("required", "Please fill in the :attribute")
+	schema, err := v.CompileOrdered([]contract.FieldRules{
+		{Field: "zip", Rules: "required"},
+		{Field: "city", Rules: "required"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected compile error: %v", err)
+	}
+
+	res := schema.Validate(testAddress{})
+	if fields := res.Fields(); len(fields) != 2 || fields[0] != "zip" || fields[1] != "city" {
+		t.Fatalf("expected declaration order, got %v", fields)
+	}
+	if got := res.FieldError("zip"); got != "Please fill in the zip" {
+		t.Fatalf("unexpected message: %q", got)
+	}
+}
+
+func TestCompile_SnapshotsMessages(t *testing.T) {
+	v := New()
+	v.SetCustomMessage("required", "Please fill in the :attribute")
+	schema, err := v.Compile(map[string]string{"zip": "required"})
+	if err != nil {
+		t.Fatalf("unexpected compile error: %v", err)
+	}
+
+	v.SetCustomMessage("required", "Changed later")
+	v.SetCustomAttribute("zip", "postal code")
+	if got := schema.Validate(map[string]any{}).FieldError("zip"); got != "Please fill in the zip" {
+		t.Fatalf("expected the messages at compile time, got %q", got)
+	}
+	if got := v.ValidateWithResult(map[string]any{}, map[string]string{"zip": "required"}).FieldError("zip"); got !=
+		"Changed later" {
+		t.Fatalf("expected the validator to use its new message, got %q", got)
+	}
+}
+
+func TestSchema_ConcurrentValidate(t *testing.T) {
+	schema, err := Compile(benchmarkRules)
+	if err != nil {
+		t.Fatalf("unexpected compile error: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(valid bool) {
+			defer wg.Done()
+			data := benchmarkData
+			if !valid {
+				data = map[string]any{"name": "J"}
+			}
+			if res := schema.Validate(data); res.IsValid() != valid {
+				t.Errorf("unexpected result for valid=%v: %v", valid, res.Errors())
+			}
+		}(i%2 == 0)
+	}
+	wg.Wait()
+}
+
+func BenchmarkValidateWithResult(b *testing.B) {
+	v := New()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		v.ValidateWithResult(benchmarkData, benchmarkRules)
+	}
+}
+
+func BenchmarkSchemaValidate(b *testing.B) {
+	schema, err := Compile(benchmarkRules)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		schema.Validate(benchmarkData)
+	}
+}
+
+func BenchmarkSchemaValidateParallel(b *testing.B) {
+	schema, err := Compile(benchmarkRules)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			schema.Validate(benchmarkData)
+		}
+	})
+}
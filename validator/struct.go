@@ -0,0 +1,278 @@
+package validator
+
+import (
+	"encoding"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/utils"
+)
+
+// Struct tag names used when validating struct data
+const (
+	ValidateTagName = "validate"
+	JSONTagName     = "json"
+)
+
+var textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+
+// structData holds the data and tag rules extracted from a struct value.
+// Rules are kept in field declaration order.
+type structData struct {
+	data      map[string]any
+	rules     []contract.FieldRules
+	seenRules map[string]bool
+	// visiting holds the pointers on the path being walked, which stops cyclic data
+	visiting map[uintptr]bool
+}
+
+// isStructData reports whether data is a struct, a pointer to a struct, or a slice of structs
+func isStructData(data any) bool {
+	if data == nil {
+		return false
+	}
+	t := derefType(reflect.TypeOf(data))
+	if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = derefType(t.Elem())
+	}
+	return isWalkableStruct(t)
+}
+
+// extractStructData walks a struct (or pointer/slice of structs) and returns its data keyed by
+// JSON field name together with the rules declared in `validate` tags, keyed by field path.
+// Rules are collected from the types, so nested fields are validated even behind nil pointers.
+// Rules of slice elements use a wildcard segment (e.g. "items.*.price") and are expanded by the
+// engine against the actual data.
+func extractStructData(data any) *structData {
+	sd := &structData{
+		data:      make(map[string]any),
+		seenRules: make(map[string]bool),
+		visiting:  make(map[uintptr]bool),
+	}
+
+	root := reflect.ValueOf(data)
+	if root.Kind() == reflect.Ptr && !root.IsNil() {
+		sd.visiting[root.Pointer()] = true
+	}
+	val := indirect(root)
+	if !val.IsValid() {
+		return sd
+	}
+
+	switch val.Kind() {
+	case reflect.Struct:
+		sd.collectRules(val.Type(), "", make(map[reflect.Type]bool))
+		sd.walkStruct(val, "", sd.data)
+	case reflect.Slice, reflect.Array:
+		if elem := derefType(val.Type().Elem()); isWalkableStruct(elem) {
+			sd.collectRules(elem, utils.PathWildcard, make(map[reflect.Type]bool))
+		}
+		for i := 0; i < val.Len(); i++ {
+			sd.data[strconv.Itoa(i)] = sd.walkValue(val.Index(i), utils.PathWildcard)
+		}
+	}
+
+	return sd
+}
+
+// collectRules records the rules declared by the fields of struct type t and of the structs
+// they nest, whether or not the data holds them. A type already being collected on the current
+// path is not entered again, which stops self-referencing types; the rules of such nested
+// values are collected while walking the data.
+func (sd *structData) collectRules(t reflect.Type, prefix string, collecting map[reflect.Type]bool) {
+	collecting[t] = true
+	defer delete(collecting, t)
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() && !field.Anonymous {
+			continue
+		}
+		name, skip := jsonFieldName(field)
+		if skip {
+			continue
+		}
+
+		fieldType := derefType(field.Type)
+		if field.Anonymous && !hasJSONName(field) && isWalkableStruct(fieldType) {
+			if !collecting[fieldType] {
+				sd.collectRules(fieldType, prefix, collecting)
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+
+		path := joinPath(prefix, name)
+		if tag := tagRules(field); tag != "" {
+			sd.addRule(path, tag)
+		}
+
+		nested := fieldType
+		if (fieldType.Kind() == reflect.Slice || fieldType.Kind() == reflect.Array) && hasStructElements(fieldType) {
+			nested, path = derefType(fieldType.Elem()), joinPath(path, utils.PathWildcard)
+		}
+		if isWalkableStruct(nested) && !collecting[nested] {
+			sd.collectRules(nested, path, collecting)
+		}
+	}
+}
+
+// walkStruct copies the exported fields of val into out and collects their rules
+func (sd *structData) walkStruct(val reflect.Value, prefix string, out map[string]any) {
+	typ := val.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		// Embedded structs of unexported types still promote their exported fields
+		if !field.IsExported() && !field.Anonymous {
+			continue
+		}
+
+		name, skip := jsonFieldName(field)
+		if skip {
+			continue
+		}
+
+		fieldVal := val.Field(i)
+
+		// Embedded structs without an explicit JSON name are promoted to the parent level,
+		// and contribute no fields when nil
+		if field.Anonymous && !hasJSONName(field) && isWalkableStruct(derefType(field.Type)) {
+			if embedded := indirect(fieldVal); embedded.IsValid() {
+				sd.walkStruct(embedded, prefix, out)
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+
+		// Rules found here belong to nested values of self-referencing types
+		path := joinPath(prefix, name)
+		if tag := tagRules(field); tag != "" {
+			sd.addRule(path, tag)
+		}
+
+		out[name] = sd.walkValue(fieldVal, path)
+	}
+}
+
+// walkValue converts a field value into its validation representation.
+// Structs become map[string]any and slices of structs become []any of maps. A pointer already
+// on the path being walked is cyclic and becomes nil.
+func (sd *structData) walkValue(val reflect.Value, path string) any {
+	if val.Kind() == reflect.Interface && !val.IsNil() {
+		val = val.Elem()
+	}
+	if val.Kind() == reflect.Ptr && !val.IsNil() {
+		ptr := val.Pointer()
+		if sd.visiting[ptr] {
+			return nil
+		}
+		sd.visiting[ptr] = true
+		defer delete(sd.visiting, ptr)
+	}
+
+	val = indirect(val)
+	if !val.IsValid() {
+		return nil
+	}
+
+	switch {
+	case isWalkableStruct(val.Type()):
+		nested := make(map[string]any)
+		sd.walkStruct(val, path, nested)
+		return nested
+	case (val.Kind() == reflect.Slice || val.Kind() == reflect.Array) && hasStructElements(val.Type()):
+		if val.Kind() == reflect.Slice && val.IsNil() {
+			return nil
+		}
+		items := make([]any, val.Len())
+		for i := 0; i < val.Len(); i++ {
+			items[i] = sd.walkValue(val.Index(i), joinPath(path, utils.PathWildcard))
+		}
+		return items
+	}
+
+	return val.Interface()
+}
+
+// addRule records the rules for a field path once; slice elements share a wildcard path
+func (sd *structData) addRule(path, rules string) {
+	if sd.seenRules[path] {
+		return
+	}
+	sd.seenRules[path] = true
+	sd.rules = append(sd.rules, contract.FieldRules{Field: path, Rules: rules})
+}
+
+// tagRules returns the rules declared in the validate tag of a field, or "" when there are none
+func tagRules(field reflect.StructField) string {
+	tag := strings.TrimSpace(field.Tag.Get(ValidateTagName))
+	if tag == "-" {
+		return ""
+	}
+	return tag
+}
+
+// jsonFieldName returns the name used for a field and whether it should be skipped
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get(JSONTagName)
+	if tag == "-" {
+		return "", true
+	}
+	if name, _, _ := strings.Cut(tag, ","); name != "" {
+		return name, false
+	}
+	return field.Name, false
+}
+
+// hasJSONName reports whether the field declares an explicit JSON name
+func hasJSONName(field reflect.StructField) bool {
+	name, _, _ := strings.Cut(field.Tag.Get(JSONTagName), ",")
+	return name != ""
+}
+
+// isWalkableStruct reports whether t is a struct whose fields should be walked.
+// Types that marshal themselves to text (e.g. time.Time) are treated as scalar values.
+func isWalkableStruct(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	return !t.Implements(textMarshalerType) && !reflect.PointerTo(t).Implements(textMarshalerType)
+}
+
+// hasStructElements reports whether a slice or array type holds structs or pointers to structs
+func hasStructElements(t reflect.Type) bool {
+	return isWalkableStruct(derefType(t.Elem()))
+}
+
+// derefType returns the type t points to through any number of pointers
+func derefType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+// indirect dereferences pointers and interfaces, returning the zero Value for nil
+func indirect(val reflect.Value) reflect.Value {
+	for val.IsValid() && (val.Kind() == reflect.Ptr || val.Kind() == reflect.Interface) {
+		if val.IsNil() {
+			return reflect.Value{}
+		}
+		val = val.Elem()
+	}
+	return val
+}
+
+// joinPath joins a field path prefix and key with the path separator
+func joinPath(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + utils.PathSeparator + key
+}
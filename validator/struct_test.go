@@ -0,0 +1,142 @@
+package validator
+
+import (
+	"testing"
+	"time"
+)
+
+type testAddress struct {
+	City    string `json:"city" validate:"required"`
+	Country string `json:"country" validate:"required|size:2"`
+}
+
+type testItem struct {
+	Name  string  `json:"name" validate:"required"`
+	Price float64 `json:"price" validate:"gt:0"`
+}
+
+type testAudit struct {
+	CreatedBy string `json:"created_by" validate:"required"`
+}
+
+type testOrder struct {
+	testAudit
+	Email    string       `json:"email" validate:"required|email"`
+	Address  *testAddress `json:"address" validate:"required"`
+	Items    []testItem   `json:"items" validate:"required"`
+	Note     string       `json:"-" validate:"required"`
+	PlacedAt time.Time    `json:"placed_at"`
+	internal string
+}
+
+func TestValidator_Struct_Valid(t *testing.T) {
+	v := New()
+	order := testOrder{
+		testAudit: testAudit{CreatedBy: "admin"},
+		Email:     "john@example.com",
+		Address:   &testAddress{City: "Berlin", Country: "DE"},
+		Items:     []testItem{{Name: "Pen", Price: 1.5}},
+		PlacedAt:  time.Now(),
+	}
+
+	res := v.ValidateWithResult(&order, nil)
+	if !res.IsValid() {
+		t.Fatalf("expected valid struct, got %v", res.Errors())
+	}
+}
+
+func TestValidator_Struct_FieldPaths(t *testing.T) {
+	v := New()
+	order := testOrder{
+		Email:   "not-an-email",
+		Address: &testAddress{City: "", Country: "DEU"},
+		Items:   []testItem{{Name: "Pen", Price: 1}, {Name: "", Price: 0}},
+	}
+
+	res := v.ValidateWithResult(order, nil)
+	invalid := []string{"email", "created_by", "address.city", "address.country", "items.1.name", "items.1.price"}
+	for _, field := range invalid {
+		if !res.HasFieldError(field) {
+			t.Errorf("expected error for %s, got %v", field, res.Errors())
+		}
+	}
+	for _, field := range []string{"items.0.name", "items.0.price", "Note", "internal"} {
+		if res.HasFieldError(field) {
+			t.Errorf("did not expect error for %s", field)
+		}
+	}
+}
+
+func TestValidator_Struct_NilPointerAndExplicitRules(t *testing.T) {
+	v := New()
+	order := &testOrder{
+		testAudit: testAudit{CreatedBy: "admin"}, Email: "a@b.com", Items: []testItem{{Name: "x", Price: 1}},
+	}
+
+	// The rules of a struct behind a nil pointer still apply
+	res := v.ValidateWithResult(order, nil)
+	fields := res.Fields()
+	if len(fields) != 3 || fields[0] != "address" || fields[1] != "address.city" || fields[2] != "address.country" {
+		t.Fatalf("expected errors for address and its fields, got %v", res.Errors())
+	}
+
+	// explicit rules override tag rules for the same field
+	order.Address = &testAddress{Country: "DE"}
+	res = v.ValidateWithResult(order, map[string]string{"address.city": "nullable"})
+	if !res.IsValid() {
+		t.Fatalf("expected explicit rule to override tag rule, got %v", res.Errors())
+	}
+}
+
+type testCategory struct {
+	Name     string         `json:"name" validate:"required"`
+	Parent   *testCategory  `json:"parent"`
+	Children []testCategory `json:"children"`
+}
+
+func TestValidator_Struct_SelfReferencingTypes(t *testing.T) {
+	v := New()
+	root := &testCategory{Name: "root", Children: []testCategory{{Name: "a", Children: []testCategory{{}}}}}
+	root.Parent = root
+
+	res := v.ValidateWithResult(root, nil)
+	if len(res.Errors()) != 1 || !res.HasFieldError("children.0.children.0.name") {
+		t.Fatalf("expected only the nested child name to fail, got %v", res.Errors())
+	}
+
+	if res = v.ValidateWithResult(&testCategory{Name: "orphan"}, nil); !res.IsValid() {
+		t.Fatalf("expected a valid category without parent or children, got %v", res.Errors())
+	}
+}
+
+func TestValidator_Struct_SliceOfStructs(t *testing.T) {
+	v := New()
+	items := []*testItem{{Name: "a", Price: 1}, {Name: "", Price: 2}}
+
+	res := v.ValidateWithResult(items, nil)
+	if !res.HasFieldError("1.name") || res.HasFieldError("0.name") {
+		t.Fatalf("unexpected errors: %v", res.Errors())
+	}
+}
+
+func TestValidator_ValidateStruct(t *testing.T) {
+	v := New()
+	if err := v.ValidateStruct(testAddress{City: "Paris", Country: "FR"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := v.ValidateStruct(&testAddress{}); err == nil {
+		t.Fatal("expected error for empty struct")
+	}
+}
+
+func TestValidator_Struct_DeclarationOrder(t *testing.T) {
+	v := New()
+	res := v.ValidateWithResult(testAddress{}, nil)
+	fields := res.Fields()
+	if len(fields) != 2 || fields[0] != "city" || fields[1] != "country" {
+		t.Fatalf("expected declaration order, got %v", fields)
+	}
+	if res.FirstError() != res.FieldError("city") {
+		t.Fatalf("expected first error to belong to city, got %q", res.FirstError())
+	}
+}
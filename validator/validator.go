@@ -7,11 +7,16 @@
 package validator
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/engine"
 	"github.com/next-trace/scg-validator/message"
+	"github.com/next-trace/scg-validator/parser"
+	"golang.org/x/text/language"
 )
 
 // Validator is the main facade that provides a simple interface for validator
@@ -30,7 +35,17 @@ func New() *Validator {
 
 // Validate validates data against the provided rules and returns an error
 func (v *Validator) Validate(data any, rules map[string]string) error {
-	result := v.ValidateWithResult(data, rules)
+	return v.ValidateContext(context.Background(), data, rules)
+}
+
+// ValidateContext validates data like Validate, passing ctx to rules and verifiers.
+// When ctx is canceled or its deadline passes, validation stops and the returned error
+// wraps contract.ErrValidationCanceled and the context error.
+func (v *Validator) ValidateContext(ctx context.Context, data any, rules map[string]string) error {
+	result, err := v.ValidateWithResultContext(ctx, data, rules)
+	if err != nil {
+		return err
+	}
 	if !result.IsValid() {
 		if validationErrors, ok := result.(*contract.ValidationErrors); ok {
 			return validationErrors
@@ -40,23 +55,58 @@ func (v *Validator) Validate(data any, rules map[string]string) error {
 	return nil
 }
 
-// ValidateWithResult validates data against the provided rules and returns the full result
+// ValidateWithResult validates data against the provided rules and returns the full result.
+// Structs, pointers to structs and slices of structs are walked via reflection: field names are
+// taken from `json` tags and rules from `validate` tags. Rules passed explicitly take precedence
+// over tag rules for the same field path.
 func (v *Validator) ValidateWithResult(data any, rules map[string]string) contract.Result {
+	result, _ := v.ValidateWithResultContext(context.Background(), data, rules)
+	return result
+}
+
+// ValidateWithResultContext is like ValidateWithResult but passes ctx to rules and verifiers.
+// It returns a non-nil error only when validation stopped early because ctx is done.
+func (v *Validator) ValidateWithResultContext(
+	ctx context.Context,
+	data any,
+	rules map[string]string,
+) (contract.Result, error) {
+	return v.ValidateOrderedContext(ctx, data, contract.SortedFieldRules(rules))
+}
+
+// ValidateOrdered validates data against an ordered rules input. Fields are validated, and
+// their errors reported, in the given order, so Result.Fields and FirstError are stable.
+func (v *Validator) ValidateOrdered(data any, rules []contract.FieldRules) contract.Result {
+	result, _ := v.ValidateOrderedContext(context.Background(), data, rules)
+	return result
+}
+
+// ValidateOrderedContext is like ValidateOrdered but passes ctx to rules and verifiers.
+func (v *Validator) ValidateOrderedContext(
+	ctx context.Context,
+	data any,
+	rules []contract.FieldRules,
+) (contract.Result, error) {
 	// Convert data to map[strings]any if needed
 	var dataMap map[string]any
 	switch d := data.(type) {
 	case map[string]any:
 		dataMap = d
 	default:
-		// TODO: Handle other data types like structs
-		dataMap = make(map[string]any)
+		if isStructData(d) {
+			extracted := extractStructData(d)
+			dataMap = extracted.data
+			rules = mergeRules(extracted.rules, rules)
+		} else {
+			dataMap = make(map[string]any)
+		}
 	}
 
 	// Create a request-scoped engine to ensure isolation between validation requests
 	requestEngine := v.createRequestScopedEngine()
 
 	dataProvider := engine.NewDataProvider(dataMap)
-	return requestEngine.Execute(dataProvider, rules)
+	return requestEngine.ExecuteOrdered(ctx, dataProvider, rules)
 }
 
 // AddRule adds a custom rule to the validator
@@ -64,6 +114,11 @@ func (v *Validator) AddRule(name string, creator contract.RuleCreator) error {
 	return v.engine.RegisterRule(name, creator)
 }
 
+// AddImplicitRule adds a custom rule that runs even when the field is missing or empty
+func (v *Validator) AddImplicitRule(name string, creator contract.RuleCreator) error {
+	return v.engine.RegisterImplicitRule(name, creator)
+}
+
 // HasRule checks if a rule exists
 func (v *Validator) HasRule(name string) bool {
 	// Use the registry from the engine to check if rule exists
@@ -76,6 +131,37 @@ func (v *Validator) GetAvailableRules() []string {
 	return v.engine.GetRegistry().List()
 }
 
+// DescribeRule returns the descriptor of a registered rule
+func (v *Validator) DescribeRule(name string) (contract.RuleDescriptor, bool) {
+	return v.engine.GetRegistry().Describe(name)
+}
+
+// RuleDescriptors returns the descriptors of all registered rules sorted by name
+func (v *Validator) RuleDescriptors() []contract.RuleDescriptor {
+	return v.engine.GetRegistry().Descriptors()
+}
+
+// CheckRules checks rule strings against the registered rule descriptors without validating any data.
+// Unknown rules wrap contract.ErrRuleNotFound and parameter mismatches wrap contract.ErrInvalidRule.
+func (v *Validator) CheckRules(rules map[string]string) error {
+	registry := v.engine.GetRegistry()
+
+	var errs []error
+	for _, fr := range contract.SortedFieldRules(rules) {
+		for _, parsedRule := range parser.ParseRules(fr.Rules) {
+			descriptor, ok := registry.Describe(parsedRule.Name)
+			if !ok {
+				errs = append(errs, fmt.Errorf("field %q: %w: %s", fr.Field, contract.ErrRuleNotFound, parsedRule.Name))
+				continue
+			}
+			if err := descriptor.CheckParams(parsedRule.Params); err != nil {
+				errs = append(errs, fmt.Errorf("field %q: %w", fr.Field, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // ValidateMap is a convenience method for validating map data with array-style rules (Laravel-style)
 func (v *Validator) ValidateMap(data map[string]interface{}, rules map[string][]string) contract.Result {
 	// Convert array-style rules to pipe-separated strings
@@ -96,6 +182,11 @@ func (v *Validator) ValidateMap(data map[string]interface{}, rules map[string][]
 	return v.ValidateWithResult(data, stringRules)
 }
 
+// ValidateStruct validates a struct using only the rules declared in its `validate` tags
+func (v *Validator) ValidateStruct(data any) error {
+	return v.Validate(data, nil)
+}
+
 // SetCustomMessage sets a custom message for a rule
 func (v *Validator) SetCustomMessage(rule, message string) {
 	v.engine.SetCustomMessage(rule, message)
@@ -106,6 +197,57 @@ func (v *Validator) SetCustomAttribute(field, name string) {
 	v.engine.SetCustomAttribute(field, name)
 }
 
+// SetMessageKeyOrder sets the canonical order of field-specific message keys. Both
+// "<rule>.<field>" and "<field>.<rule>" are accepted; keys in the canonical order win when a
+// field has both. It has no effect on custom resolvers without key order support.
+func (v *Validator) SetMessageKeyOrder(order message.KeyOrder) {
+	if resolver, ok := v.engine.GetMessageResolver().(interface{ SetKeyOrder(message.KeyOrder) }); ok {
+		resolver.SetKeyOrder(order)
+	}
+}
+
+// ExplainMessage reports the message a rule failure on field would produce and the layer of the
+// precedence chain it comes from. It reports false for custom resolvers that cannot explain
+// their messages.
+func (v *Validator) ExplainMessage(rule, field string, value any, params []string) (message.Resolution, bool) {
+	explainer, ok := v.engine.GetMessageResolver().(interface {
+		Explain(rule, field string, value any, parameters []string) message.Resolution
+	})
+	if !ok {
+		return message.Resolution{}, false
+	}
+	return explainer.Explain(rule, field, value, params), true
+}
+
+// SetConcurrency validates up to workers fields in parallel, which helps when rules perform I/O
+// such as exists or unique. Results and error order are the same as in sequential mode.
+func (v *Validator) SetConcurrency(workers int) {
+	v.engine.SetConcurrency(workers)
+}
+
+// SetClock sets the clock that date rules evaluate "now", "today", "+7 days" and similar
+// comparison dates against. Use contract.FixedClock to make such rules deterministic in tests.
+func (v *Validator) SetClock(clock contract.Clock) {
+	v.engine.SetClock(clock)
+}
+
+// SetTimezone sets the timezone that date rules read dates without a zone in and evaluate
+// relative dates in. Dates default to UTC.
+func (v *Validator) SetTimezone(loc *time.Location) {
+	v.engine.SetLocation(loc)
+}
+
+// WithLocale returns a validator that reports messages and attribute names in the catalog best
+// matching tag. Catalogs are registered with message.RegisterCatalog; a locale without its own
+// catalog falls back to its parent locales and then to English. The receiver is not modified.
+func (v *Validator) WithLocale(tag language.Tag) *Validator {
+	scoped := v.createRequestScopedEngine()
+	if resolver, ok := scoped.GetMessageResolver().(contract.LocaleResolver); ok {
+		resolver.SetLocale(tag)
+	}
+	return &Validator{engine: scoped}
+}
+
 // createRequestScopedEngine creates a new engine instance with isolated message resolver
 // This ensures that custom messages and attributes don't interfere between validation requests
 func (v *Validator) createRequestScopedEngine() contract.ValidationEngine {
@@ -120,3 +262,28 @@ func (v *Validator) createRequestScopedEngine() contract.ValidationEngine {
 	// Create a request-scoped engine that shares the same registry but uses the cloned resolver
 	return v.engine.CloneWithResolver(requestResolver)
 }
+
+// mergeRules combines tag rules with explicit rules, letting explicit rules win per field.
+// Tag rules keep their declaration order and explicit-only fields follow in their given order.
+func mergeRules(tagRules, explicitRules []contract.FieldRules) []contract.FieldRules {
+	explicit := make(map[string]string, len(explicitRules))
+	for _, fr := range explicitRules {
+		explicit[fr.Field] = fr.Rules
+	}
+
+	merged := make([]contract.FieldRules, 0, len(tagRules)+len(explicitRules))
+	seen := make(map[string]bool, len(tagRules))
+	for _, fr := range tagRules {
+		if rules, ok := explicit[fr.Field]; ok {
+			fr.Rules = rules
+		}
+		seen[fr.Field] = true
+		merged = append(merged, fr)
+	}
+	for _, fr := range explicitRules {
+		if !seen[fr.Field] {
+			merged = append(merged, fr)
+		}
+	}
+	return merged
+}
@@ -1,10 +1,16 @@
 package validator
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/message"
+	"github.com/next-trace/scg-validator/utils"
+	"golang.org/x/text/language"
 )
 
 func TestValidator_Validate_Success(t *testing.T) {
@@ -118,6 +124,36 @@ func TestValidator_UnknownRule(t *testing.T) {
 	}
 }
 
+func TestValidator_ValidateContext_DeadlineExceeded(t *testing.T) {
+	v := New()
+	ctx, cancel := context.WithTimeout(context.Background(), -time.Second)
+	defer cancel()
+
+	err := v.ValidateContext(ctx, map[string]any{"name": "John"}, map[string]string{"name": "required"})
+	if !errors.Is(err, contract.ErrValidationCanceled) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline error, got %v", err)
+	}
+
+	if err := v.ValidateContext(context.Background(), map[string]any{"name": "John"},
+		map[string]string{"name": "required"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestValidator_ValidateOrdered(t *testing.T) {
+	v := New()
+	res := v.ValidateOrdered(map[string]any{}, []contract.FieldRules{
+		{Field: "name", Rules: "required"},
+		{Field: "email", Rules: "required"},
+	})
+	if fields := res.Fields(); len(fields) != 2 || fields[0] != "name" || fields[1] != "email" {
+		t.Fatalf("expected declaration order, got %v", fields)
+	}
+	if res.FirstError() != res.FieldError("name") {
+		t.Fatalf("unexpected first error: %q", res.FirstError())
+	}
+}
+
 // Integrated from integration_rules_test.go to keep all validator facade tests in one file.
 func TestValidator_MultiRuleIntegration(t *testing.T) {
 	v := New()
@@ -177,3 +213,340 @@ func TestValidator_MultiRuleIntegration(t *testing.T) {
 		}
 	}
 }
+
+func TestValidator_DescribeAndCheckRules(t *testing.T) {
+	v := New()
+	if d, ok := v.DescribeRule("between"); !ok || len(d.Params) != 2 {
+		t.Fatalf("unexpected between descriptor: %+v", d)
+	}
+	if len(v.RuleDescriptors()) != len(v.GetAvailableRules()) {
+		t.Fatal("expected a descriptor for every rule")
+	}
+
+	valid := map[string]string{"age": "required|between:1,10", "tos": "accepted_if:status,on"}
+	if err := v.CheckRules(valid); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err := v.CheckRules(map[string]string{"age": "between:1", "name": "nonexistent"})
+	if !errors.Is(err, contract.ErrInvalidRule) || !errors.Is(err, contract.ErrRuleNotFound) {
+		t.Fatalf("expected both errors, got %v", err)
+	}
+}
+
+func TestValidator_SetConcurrency(t *testing.T) {
+	data := map[string]any{"name": "J", "email": "bad", "age": "x"}
+	rules := map[string]string{"name": "required|min:2", "email": "email", "age": "integer", "zip": "required"}
+
+	sequential := New().ValidateWithResult(data, rules)
+	v := New()
+	v.SetConcurrency(4)
+	concurrent := v.ValidateWithResult(data, rules)
+
+	got, want := concurrent.Fields(), sequential.Fields()
+	if len(got) != len(want) {
+		t.Fatalf("unexpected fields: %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("unexpected field order: %v, want %v", got, want)
+		}
+	}
+}
+
+func TestValidator_WithLocale(t *testing.T) {
+	message.RegisterCatalog(message.NewCatalog(language.Dutch, map[string]string{
+		"required": ":attribute is verplicht",
+	}, map[string]string{"name": "naam"}))
+
+	v := New()
+	v.SetCustomMessage("email", ":attribute is ongeldig")
+	dutch := v.WithLocale(language.MustParse("nl-BE"))
+	data := map[string]any{"email": "bad"}
+	rules := map[string]string{"name": "required", "email": "email"}
+
+	res := dutch.ValidateWithResult(data, rules)
+	if got := res.FieldError("name"); got != "naam is verplicht" {
+		t.Fatalf("unexpected localized errors: %v", got)
+	}
+	if got := res.FieldError("email"); got != "email is ongeldig" {
+		t.Fatalf("custom messages must carry over: %v", got)
+	}
+
+	res = v.ValidateWithResult(data, rules)
+	if got := res.FieldError("name"); got != "The name field is required" {
+		t.Fatalf("original validator must stay in English: %v", got)
+	}
+}
+
+func TestValidator_SizeMessagesDependOnValueType(t *testing.T) {
+	data := map[string]any{"name": "Al", "tags": []any{"a"}, "age": 15}
+	rules := map[string]string{"name": "min:3", "tags": "min:2", "age": "min:18"}
+
+	res := New().ValidateWithResult(data, rules)
+	want := map[string]string{
+		"name": "The name must be at least 3 characters",
+		"tags": "The tags must have at least 2 items",
+		"age":  "The age must be at least 18",
+	}
+	for field, msg := range want {
+		if got := res.FieldError(field); got != msg {
+			t.Errorf("FieldError(%s) = %q, want %q", field, got, msg)
+		}
+	}
+}
+
+func TestValidator_NamedPlaceholders(t *testing.T) {
+	v := New()
+	v.SetCustomAttribute("payment_type", "payment type")
+	data := map[string]any{"payment_type": "cash", "coupon": "X1", "code": "tmp-1"}
+	rules := map[string]string{
+		"coupon": "prohibited_if:payment_type,cash,cheque",
+		"code":   "doesnt_start_with:tmp,test",
+	}
+
+	res := v.ValidateWithResult(data, rules)
+	if got := res.FieldError("coupon"); got != "The coupon field is prohibited when payment type is cash, cheque" {
+		t.Errorf("unexpected prohibited_if message: %q", got)
+	}
+	if got := res.FieldError("code"); got != "The code must not start with one of the following: tmp, test" {
+		t.Errorf("unexpected doesnt_start_with message: %q", got)
+	}
+}
+
+func TestValidator_CatalogPlaceholdersBindParameters(t *testing.T) {
+	v := New()
+	v.SetCustomAttribute("payment_type", "payment type")
+	data := map[string]any{
+		"payment_type": "cash", "code": "baz", "terms": "no", "newsletter": "yes",
+		"day": "17/10/2026", "due": "2026-10-18", "price": "1.5", "rate": "1.555",
+	}
+	rules := map[string]string{
+		"code":       "starts_with:foo,bar",
+		"terms":      "accepted_with:payment_type",
+		"newsletter": "declined_unless:payment_type,card,cheque",
+		"day":        "date_format:Y-m-d",
+		"due":        "date_equals:2026-10-17",
+		"price":      "decimal:2",
+		"rate":       "decimal:1,2",
+	}
+
+	res := v.ValidateWithResult(data, rules)
+	want := map[string]string{
+		"code":       "The code must start with one of the following: foo, bar",
+		"terms":      "The terms must be accepted when payment type is present",
+		"newsletter": "The newsletter must be declined unless payment type is card, cheque",
+		"day":        "The day does not match the format Y-m-d",
+		"due":        "The due must be a date equal to 2026-10-17",
+		"price":      "The price must have 2 decimal places",
+		"rate":       "The rate must have between 1 and 2 decimal places",
+	}
+	for field, msg := range want {
+		if got := res.FieldError(field); got != msg {
+			t.Errorf("FieldError(%s) = %q, want %q", field, got, msg)
+		}
+	}
+}
+
+func TestValidator_WildcardMessagesAndAttributes(t *testing.T) {
+	v := New()
+	v.SetCustomMessage("required.items.*.price", "The :attribute is required")
+	v.SetCustomAttribute("items.*.price", "price of item #:position")
+	data := map[string]any{"items": []any{
+		map[string]any{"price": 10},
+		map[string]any{"price": 5},
+		map[string]any{},
+	}}
+
+	res := v.ValidateWithResult(data, map[string]string{"items.*.price": "required"})
+	if got := res.FieldError("items.2.price"); got != "The price of item #3 is required" {
+		t.Errorf("unexpected wildcard message: %q", got)
+	}
+}
+
+func TestValidator_ExplainMessageAndKeyOrder(t *testing.T) {
+	v := New()
+	v.SetCustomMessage("required", "Fill in :attribute")
+	v.SetCustomMessage("email.required", "We need your email")
+	v.SetCustomMessage("required.email", "Email is required")
+
+	res := v.ValidateWithResult(map[string]any{}, map[string]string{"email": "required", "name": "required"})
+	if got := res.FieldError("email"); got != "Email is required" {
+		t.Errorf("expected field-specific message, got %q", got)
+	}
+	if got := res.FieldError("name"); got != "Fill in name" {
+		t.Errorf("expected rule message, got %q", got)
+	}
+
+	v.SetMessageKeyOrder(message.FieldRuleOrder)
+	explained, ok := v.ExplainMessage("required", "email", nil, nil)
+	if !ok || explained.Message != "We need your email" || explained.Source != message.SourceInstanceField {
+		t.Errorf("unexpected explanation: %+v", explained)
+	}
+	if got := v.ValidateWithResult(map[string]any{}, map[string]string{"email": "required"}).FieldError("email"); got !=
+		"We need your email" {
+		t.Errorf("expected the canonical key order to apply to validation, got %q", got)
+	}
+}
+
+func TestValidator_TranslateError(t *testing.T) {
+	v := New()
+	v.SetCustomAttribute("email", "email address")
+	err := v.Validate(map[string]any{"status": "active", "email": "nope"}, map[string]string{
+		"phone": "required_if:status,active",
+		"email": "email",
+	})
+
+	got := utils.TranslateError(err)
+	if want := "This field is required when status is active."; got["phone"] != want {
+		t.Errorf("expected %q, got %q", want, got["phone"])
+	}
+	if want := "This field must be a valid email address."; got["email"] != want {
+		t.Errorf("expected %q, got %q", want, got["email"])
+	}
+
+	got = utils.TranslateError(err, utils.WithWording(utils.AttributeWording))
+	if want := "The email address must be a valid email address."; got["email"] != want {
+		t.Errorf("expected %q, got %q", want, got["email"])
+	}
+}
+
+func TestValidator_RuleAliasesAndNewRules(t *testing.T) {
+	v := New()
+	data := map[string]any{"code": "a-1", "host": "::1", "nic": "nope", "day": "2024/02/29", "name": 7}
+	res := v.ValidateWithResult(data, map[string]string{
+		"code": "alphanum",
+		"host": "ipv4",
+		"nic":  "mac",
+		"day":  "date_format:2006-01-02",
+		"name": "string",
+	})
+
+	want := map[string]string{
+		"code": "The code may only contain letters and numbers",
+		"host": "The host must be a valid IPv4 address",
+		"nic":  "The nic must be a valid MAC address",
+		"day":  "The day does not match the format 2006-01-02",
+		"name": "The name must be a string",
+	}
+	for field, msg := range want {
+		if got := res.FieldError(field); got != msg {
+			t.Errorf("FieldError(%s) = %q, want %q", field, got, msg)
+		}
+	}
+
+	for _, failure := range res.Failures() {
+		if failure.Field == "code" && failure.Rule != "alpha_num" {
+			t.Errorf("expected the alias to fail under its canonical name, got %s", failure.Rule)
+		}
+	}
+}
+
+func TestValidator_RelativeAndFieldDates(t *testing.T) {
+	v := New()
+	v.SetClock(contract.FixedClock(time.Date(2024, 3, 10, 22, 30, 0, 0, time.UTC)))
+	v.SetTimezone(time.FixedZone("JST", 9*60*60))
+	v.SetCustomAttribute("start_date", "start date")
+
+	data := map[string]any{"start_date": "2024-03-12", "end_date": "2024-03-12", "delivery": "2024-03-11"}
+	res := v.ValidateWithResult(data, map[string]string{
+		"start_date": "after:today,2006-01-02",
+		"end_date":   "after:start_date,2006-01-02",
+		"delivery":   "after_or_equal:tomorrow,2006-01-02",
+	})
+
+	if res.HasFieldError("start_date") {
+		t.Errorf("did not expect start_date to fail: %v", res.Errors())
+	}
+	if got := res.FieldError("end_date"); got != "The end_date must be a date after start date" {
+		t.Errorf("unexpected end_date message: %q", got)
+	}
+	// 22:30 UTC is already March 11 in Tokyo, so tomorrow is March 12
+	if !res.HasFieldError("delivery") {
+		t.Error("expected delivery to fail in the configured timezone")
+	}
+}
+
+func TestValidator_DateRulesOnTimeValues(t *testing.T) {
+	type booking struct {
+		Start     time.Time  `json:"start" validate:"required|date"`
+		End       *time.Time `json:"end" validate:"required|after:start"`
+		CreatedAt int64      `json:"created_at" validate:"date:U|before:now,U"`
+	}
+
+	start := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	end := start.Add(-time.Hour)
+	err := New().ValidateStruct(booking{Start: start, End: &end, CreatedAt: 1714550400})
+
+	var fields map[string][]string
+	if errs, ok := err.(interface{ Errors() map[string][]string }); ok {
+		fields = errs.Errors()
+	}
+	if len(fields) != 1 || len(fields["end"]) != 1 {
+		t.Fatalf("expected only end to fail, got %v", err)
+	}
+}
+
+func TestValidator_ComparisonsMeasureStringsUnlessNumeric(t *testing.T) {
+	v := New()
+	res := v.ValidateWithResult(map[string]any{"code": "12345", "day": "2024-01-01", "qty": "10"}, map[string]string{
+		"code": "string|gte:5|lte:5|between:5,5",
+		"day":  "string|gt:5|lt:20|between:1,20",
+		"qty":  "numeric|gt:5|between:6,10",
+	})
+	if !res.IsValid() {
+		t.Fatalf("expected strings to be measured by length and numeric fields by value, got %v", res.Errors())
+	}
+}
+
+func TestValidator_FieldReferencingComparisons(t *testing.T) {
+	v := New()
+	v.SetCustomAttribute("max_price", "maximum price")
+	v.SetCustomAttribute("starts_at", "start time")
+	v.SetCustomAttribute("limits.high", "upper limit")
+
+	data := map[string]any{
+		"limits":    map[string]any{"low": 1},
+		"score":     2,
+		"min_price": "19.99",
+		"max_price": "19.99",
+		"pages":     map[string]any{"start": 12, "end": 10},
+		"quantity":  json.Number("3"),
+		"stock":     4,
+		"nickname":  "al",
+		"name":      "alex",
+		"discount":  []any{"ten"},
+		"starts_at": "2024-05-01T10:00:00Z",
+		"ends_at":   "2024-05-01T09:00:00+02:00",
+	}
+	res := v.ValidateWithResult(data, map[string]string{
+		"max_price": "numeric|gt:min_price",
+		"pages.end": "gte:pages.start",
+		"quantity":  "lte:stock",
+		"nickname":  "gt:name",
+		"discount":  "lt:max_price",
+		"ends_at":   "gt:starts_at",
+		"score":     "between:limits.low,limits.high",
+	})
+
+	if got := res.FieldError("max_price"); got != "The maximum price must be greater than 19.99" {
+		t.Errorf("unexpected max_price message: %q", got)
+	}
+	if got := res.FieldError("pages.end"); got != "The pages.end must be greater than or equal to 12" {
+		t.Errorf("unexpected pages.end message: %q", got)
+	}
+	if res.HasFieldError("quantity") {
+		t.Errorf("did not expect quantity to fail: %v", res.Errors())
+	}
+	if got := res.FieldError("nickname"); got != "The nickname must be greater than 4 characters" {
+		t.Errorf("unexpected nickname message: %q", got)
+	}
+	if got := res.FieldError("discount"); got != "The discount must be of the same type as maximum price" {
+		t.Errorf("unexpected discount message: %q", got)
+	}
+	if got := res.FieldError("ends_at"); got != "The ends_at must be a date after start time" {
+		t.Errorf("unexpected ends_at message: %q", got)
+	}
+	if got := res.FieldError("score"); got != "The score cannot be compared with upper limit, which is missing" {
+		t.Errorf("unexpected score message: %q", got)
+	}
+}